@@ -0,0 +1,259 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultConcurrency 默认并发检查数
+const DefaultConcurrency = 10
+
+// DefaultTimeout 单个检查的默认超时时间
+const DefaultTimeout = 10 * time.Second
+
+// 目标的检查方式
+const (
+	// CheckInventory 与 CloudFront 分发列表比对
+	CheckInventory = "inventory"
+	// CheckNXDomain 目标域名不存在 (NXDOMAIN)
+	CheckNXDomain = "nxdomain"
+	// CheckS3Bucket 以记录名称为 Host 请求 S3，返回 NoSuchBucket
+	CheckS3Bucket = "s3-bucket"
+)
+
+// 检查结果状态
+const (
+	StatusDangling = "dangling"
+	StatusOK       = "ok"
+	StatusSkipped  = "skipped"
+	StatusError    = "error"
+)
+
+// s3BodyLimit 读取 S3 响应体的最大字节数
+const s3BodyLimit = 4096
+
+// Provider 已知的云服务目标
+type Provider struct {
+	Name    string
+	Pattern *regexp.Regexp
+	Check   string
+}
+
+// Providers 已知的云服务，按顺序匹配 CNAME 目标
+var Providers = []Provider{
+	{Name: "cloudfront", Pattern: regexp.MustCompile(`\.cloudfront\.net$`), Check: CheckInventory},
+	{Name: "s3", Pattern: regexp.MustCompile(`(^|\.)s3([.-][a-z0-9-]+)*\.amazonaws\.com$`), Check: CheckS3Bucket},
+	{Name: "elb", Pattern: regexp.MustCompile(`\.elb\.amazonaws\.com$`), Check: CheckNXDomain},
+	{Name: "elasticbeanstalk", Pattern: regexp.MustCompile(`\.elasticbeanstalk\.com$`), Check: CheckNXDomain},
+	{Name: "azure", Pattern: regexp.MustCompile(`\.(azurewebsites\.net|cloudapp\.net|cloudapp\.azure\.com|trafficmanager\.net|blob\.core\.windows\.net|azureedge\.net)$`), Check: CheckNXDomain},
+}
+
+// MatchProvider 返回 CNAME 目标对应的云服务，不属于已知云服务时返回 nil
+func MatchProvider(target string) *Provider {
+	target = normalizeHost(target)
+	for i := range Providers {
+		if Providers[i].Pattern.MatchString(target) {
+			return &Providers[i]
+		}
+	}
+	return nil
+}
+
+// Record 待检查的 CNAME 记录
+type Record struct {
+	Zone   string
+	Name   string
+	Target string
+}
+
+// Finding 单条记录的检查结果
+type Finding struct {
+	Zone     string
+	Name     string
+	Target   string
+	Provider string
+	Status   string
+	Reason   string
+}
+
+// Dangling 检查目标是否已不存在
+func (f Finding) Dangling() bool {
+	return f.Status == StatusDangling
+}
+
+// HostResolver 域名解析接口，*net.Resolver 实现了该接口
+type HostResolver interface {
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// DanglingOptions 悬空记录检查选项
+type DanglingOptions struct {
+	// CloudFrontDomains 现有 CloudFront 分发的域名（小写），为 nil 时跳过 CloudFront 目标
+	CloudFrontDomains map[string]bool
+	// Resolver 检查 NXDOMAIN 使用的解析器，为 nil 时使用系统解析器
+	Resolver HostResolver
+	// HTTPClient 检查 S3 使用的 HTTP 客户端，为 nil 时使用不跟随重定向的默认客户端
+	HTTPClient *http.Client
+	// Timeout 单个检查的超时时间
+	Timeout time.Duration
+	// Concurrency 并发数
+	Concurrency int
+}
+
+// ScanDangling 并发检查指向已知云服务的 CNAME 记录，目标不属于已知云服务的记录会被忽略
+// 返回结果的顺序与输入一致
+func ScanDangling(ctx context.Context, records []Record, opts DanglingOptions) []Finding {
+	if opts.Resolver == nil {
+		opts.Resolver = net.DefaultResolver
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultTimeout
+	}
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = &http.Client{
+			Timeout: opts.Timeout,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+	}
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+
+	var targets []Record
+	var providers []*Provider
+	for _, record := range records {
+		if provider := MatchProvider(record.Target); provider != nil {
+			targets = append(targets, record)
+			providers = append(providers, provider)
+		}
+	}
+
+	findings := make([]Finding, len(targets))
+
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, concurrency)
+
+	for i, record := range targets {
+		wg.Add(1)
+		go func(i int, record Record) {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			findings[i] = checkRecord(ctx, record, providers[i], opts)
+		}(i, record)
+	}
+
+	wg.Wait()
+	return findings
+}
+
+// checkRecord 按云服务的检查方式检查单条记录
+func checkRecord(ctx context.Context, record Record, provider *Provider, opts DanglingOptions) Finding {
+	finding := Finding{
+		Zone:     record.Zone,
+		Name:     normalizeHost(record.Name),
+		Target:   normalizeHost(record.Target),
+		Provider: provider.Name,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	var err error
+	switch provider.Check {
+	case CheckInventory:
+		checkInventory(&finding, opts.CloudFrontDomains)
+	case CheckNXDomain:
+		err = checkNXDomain(ctx, &finding, opts.Resolver)
+	case CheckS3Bucket:
+		err = checkS3Bucket(ctx, &finding, opts.HTTPClient)
+	default:
+		err = fmt.Errorf("未知的检查方式: %s", provider.Check)
+	}
+
+	if err != nil {
+		finding.Status = StatusError
+		finding.Reason = err.Error()
+	}
+	return finding
+}
+
+// checkInventory 检查目标是否在 CloudFront 分发列表中
+func checkInventory(finding *Finding, domains map[string]bool) {
+	switch {
+	case domains == nil:
+		finding.Status = StatusSkipped
+		finding.Reason = "未获取 CloudFront 分发列表"
+	case domains[finding.Target]:
+		finding.Status = StatusOK
+	default:
+		finding.Status = StatusDangling
+		finding.Reason = "当前账号中不存在该 CloudFront 分发"
+	}
+}
+
+// checkNXDomain 检查目标域名是否还能解析
+func checkNXDomain(ctx context.Context, finding *Finding, resolver HostResolver) error {
+	_, err := resolver.LookupHost(ctx, finding.Target)
+	if err == nil {
+		finding.Status = StatusOK
+		return nil
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+		finding.Status = StatusDangling
+		finding.Reason = "目标域名不存在 (NXDOMAIN)"
+		return nil
+	}
+	return fmt.Errorf("解析目标失败: %w", err)
+}
+
+// checkS3Bucket 以记录名称为 Host 请求 S3 端点，返回 NoSuchBucket 时存储桶已被删除
+// S3 端点的域名总能解析，只能通过响应判断存储桶是否存在
+func checkS3Bucket(ctx context.Context, finding *Finding, client *http.Client) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+finding.Target+"/", nil)
+	if err != nil {
+		return fmt.Errorf("创建请求失败: %w", err)
+	}
+	req.Host = finding.Name
+	req.Header.Set("User-Agent", "cloudctl-audit")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("请求 S3 失败: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, s3BodyLimit))
+	if err != nil {
+		return fmt.Errorf("读取 S3 响应失败: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound && strings.Contains(string(body), "NoSuchBucket") {
+		finding.Status = StatusDangling
+		finding.Reason = fmt.Sprintf("S3 存储桶 %s 不存在 (NoSuchBucket)", finding.Name)
+		return nil
+	}
+
+	finding.Status = StatusOK
+	return nil
+}
+
+// normalizeHost 规范化主机名：小写并去掉末尾的点
+func normalizeHost(host string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(host)), ".")
+}
@@ -0,0 +1,128 @@
+package audit
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// fakeResolver 只解析 hosts 中的域名，其他域名返回 NXDOMAIN
+type fakeResolver struct {
+	hosts map[string]bool
+	fail  map[string]bool
+}
+
+func (r fakeResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	if r.fail[host] {
+		return nil, &net.DNSError{Err: "server misbehaving", Name: host, IsTemporary: true}
+	}
+	if r.hosts[host] {
+		return []string{"192.0.2.1"}, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+}
+
+func TestMatchProvider(t *testing.T) {
+	tests := []struct {
+		target string
+		want   string
+	}{
+		{"d111111abcdef8.cloudfront.net.", "cloudfront"},
+		{"assets.s3.amazonaws.com", "s3"},
+		{"assets.s3.eu-west-1.amazonaws.com", "s3"},
+		{"www.example.com.s3-website-us-east-1.amazonaws.com", "s3"},
+		{"www.example.com.s3-website.eu-central-1.amazonaws.com", "s3"},
+		{"my-lb-123.us-east-1.elb.amazonaws.com", "elb"},
+		{"app.us-east-1.elasticbeanstalk.com", "elasticbeanstalk"},
+		{"app.azurewebsites.net", "azure"},
+		{"lb.example.net", ""},
+		{"s3.example.com", ""},
+	}
+
+	for _, tt := range tests {
+		got := ""
+		if provider := MatchProvider(tt.target); provider != nil {
+			got = provider.Name
+		}
+		if got != tt.want {
+			t.Errorf("MatchProvider(%q) = %q, want %q", tt.target, got, tt.want)
+		}
+	}
+}
+
+func TestScanDangling(t *testing.T) {
+	// 模拟 S3: 只有 live.example.com 存储桶存在
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Host == "live.example.com" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("<Error><Code>NoSuchBucket</Code></Error>"))
+	}))
+	defer server.Close()
+
+	// 所有请求都发送到模拟服务器
+	addr := strings.TrimPrefix(server.URL, "http://")
+	client := &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, network, addr)
+		},
+	}}
+
+	records := []Record{
+		{Zone: "example.com", Name: "cdn.example.com", Target: "d111111abcdef8.cloudfront.net"},
+		{Zone: "example.com", Name: "old.example.com", Target: "D222222ABCDEF8.cloudfront.net."},
+		{Zone: "example.com", Name: "blog.example.com", Target: "blog.example.net"},
+		{Zone: "example.com", Name: "live.example.com", Target: "live.example.com.s3-website-us-east-1.amazonaws.com"},
+		{Zone: "example.com", Name: "gone.example.com", Target: "gone.example.com.s3-website-us-east-1.amazonaws.com"},
+		{Zone: "test.com", Name: "app.test.com", Target: "app.azurewebsites.net"},
+		{Zone: "test.com", Name: "old-app.test.com", Target: "old-app.azurewebsites.net"},
+		{Zone: "test.com", Name: "lb.test.com", Target: "lb-1.us-east-1.elb.amazonaws.com"},
+	}
+
+	findings := ScanDangling(context.Background(), records, DanglingOptions{
+		CloudFrontDomains: map[string]bool{"d111111abcdef8.cloudfront.net": true},
+		Resolver: fakeResolver{
+			hosts: map[string]bool{"app.azurewebsites.net": true},
+			fail:  map[string]bool{"lb-1.us-east-1.elb.amazonaws.com": true},
+		},
+		HTTPClient: client,
+	})
+
+	want := []struct {
+		name   string
+		status string
+	}{
+		{"cdn.example.com", StatusOK},
+		{"old.example.com", StatusDangling},
+		{"live.example.com", StatusOK},
+		{"gone.example.com", StatusDangling},
+		{"app.test.com", StatusOK},
+		{"old-app.test.com", StatusDangling},
+		{"lb.test.com", StatusError},
+	}
+
+	if len(findings) != len(want) {
+		t.Fatalf("ScanDangling() 返回 %d 条结果, want %d: %+v", len(findings), len(want), findings)
+	}
+	for i, w := range want {
+		if findings[i].Name != w.name || findings[i].Status != w.status {
+			t.Errorf("findings[%d] = %s %s (%s), want %s %s", i, findings[i].Name, findings[i].Status, findings[i].Reason, w.name, w.status)
+		}
+	}
+}
+
+func TestScanDanglingWithoutCloudFront(t *testing.T) {
+	records := []Record{
+		{Zone: "example.com", Name: "cdn.example.com", Target: "d111111abcdef8.cloudfront.net"},
+	}
+
+	findings := ScanDangling(context.Background(), records, DanglingOptions{})
+	if len(findings) != 1 || findings[0].Status != StatusSkipped {
+		t.Errorf("ScanDangling() = %+v, want skipped", findings)
+	}
+}
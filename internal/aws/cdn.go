@@ -22,6 +22,8 @@ type Distribution struct {
 	Aliases     []string
 	Comment     string
 	CreatedTime time.Time
+	// CertificateARN 分发使用的 ACM 证书 ARN（使用默认证书时为空）
+	CertificateARN string
 }
 
 // Origin 源站信息
@@ -91,6 +93,27 @@ func (c *Client) GetDistribution(ctx context.Context, distributionID string) (*D
 	return &dist, nil
 }
 
+// WaitForDistributionDeployed 等待分发部署完成（状态变为 Deployed）
+func (c *Client) WaitForDistributionDeployed(ctx context.Context, distributionID string, cfg PollConfig) (*Distribution, error) {
+	logger.Debug("等待分发部署完成", "id", distributionID)
+
+	var dist *Distribution
+	err := Poll(ctx, cfg, func(ctx context.Context) (bool, string, error) {
+		d, err := c.GetDistribution(ctx, distributionID)
+		if err != nil {
+			return false, "", err
+		}
+		dist = d
+		return d.Status == "Deployed", d.Status, nil
+	})
+	if err != nil {
+		return dist, err
+	}
+
+	logger.Info("分发部署完成", "id", distributionID)
+	return dist, nil
+}
+
 // CreateDistributionInput 创建分发的输入参数
 type CreateDistributionInput struct {
 	OriginDomain      string   // 源站域名
@@ -297,6 +320,11 @@ func convertDistributionSummary(item types.DistributionSummary) Distribution {
 		dist.Aliases = item.Aliases.Items
 	}
 
+	// 转换证书
+	if item.ViewerCertificate != nil {
+		dist.CertificateARN = safeString(item.ViewerCertificate.ACMCertificateArn)
+	}
+
 	// 转换源站
 	if item.Origins != nil && item.Origins.Items != nil {
 		for _, origin := range item.Origins.Items {
@@ -328,6 +356,11 @@ func convertDistribution(dist *types.Distribution) Distribution {
 			result.Aliases = dist.DistributionConfig.Aliases.Items
 		}
 
+		// 转换证书
+		if dist.DistributionConfig.ViewerCertificate != nil {
+			result.CertificateARN = safeString(dist.DistributionConfig.ViewerCertificate.ACMCertificateArn)
+		}
+
 		// 转换源站
 		if dist.DistributionConfig.Origins != nil && dist.DistributionConfig.Origins.Items != nil {
 			for _, origin := range dist.DistributionConfig.Origins.Items {
@@ -480,6 +513,27 @@ func (c *Client) GetInvalidation(ctx context.Context, distributionID, invalidati
 	return invalidation, nil
 }
 
+// WaitForInvalidationCompleted 等待缓存失效完成（状态变为 Completed）
+func (c *Client) WaitForInvalidationCompleted(ctx context.Context, distributionID, invalidationID string, cfg PollConfig) (*Invalidation, error) {
+	logger.Debug("等待缓存失效完成", "distribution_id", distributionID, "invalidation_id", invalidationID)
+
+	var invalidation *Invalidation
+	err := Poll(ctx, cfg, func(ctx context.Context) (bool, string, error) {
+		inv, err := c.GetInvalidation(ctx, distributionID, invalidationID)
+		if err != nil {
+			return false, "", err
+		}
+		invalidation = inv
+		return inv.Status == "Completed", inv.Status, nil
+	})
+	if err != nil {
+		return invalidation, err
+	}
+
+	logger.Info("缓存失效完成", "invalidation_id", invalidationID)
+	return invalidation, nil
+}
+
 // ListInvalidations 列出分发的所有缓存失效
 func (c *Client) ListInvalidations(ctx context.Context, distributionID string) ([]Invalidation, error) {
 	logger.Debug("列出缓存失效", "distribution_id", distributionID)
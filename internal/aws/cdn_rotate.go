@@ -0,0 +1,220 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+
+	"github.com/ado1t/cloudctl/internal/logger"
+)
+
+// rotateMaxAttempts ETag 冲突时更新分发的最大尝试次数
+const rotateMaxAttempts = 3
+
+// RotationPlan 证书轮换计划
+type RotationPlan struct {
+	FromARN string
+	ToARN   string
+	// Target 新证书详情
+	Target *Certificate
+	Items  []RotationPlanItem
+}
+
+// RotationPlanItem 单个分发的轮换计划
+type RotationPlanItem struct {
+	Distribution Distribution
+	// Uncovered 新证书未覆盖的自定义域名
+	Uncovered []string
+}
+
+// RotationResult 单个分发的轮换结果
+type RotationResult struct {
+	DistributionID string
+	Success        bool
+	Error          string
+}
+
+// Covered 检查计划中的所有分发是否都被新证书覆盖
+func (p *RotationPlan) Covered() bool {
+	for _, item := range p.Items {
+		if len(item.Uncovered) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// PlanCertificateRotation 生成证书轮换计划
+// 找出所有使用旧证书的分发，并检查新证书是否覆盖每个分发的自定义域名
+func (c *Client) PlanCertificateRotation(ctx context.Context, fromARN, toARN string) (*RotationPlan, error) {
+	logger.Debug("生成证书轮换计划", "from", fromARN, "to", toARN)
+
+	if fromARN == "" || toARN == "" {
+		return nil, fmt.Errorf("必须同时指定旧证书和新证书的 ARN")
+	}
+	if fromARN == toARN {
+		return nil, fmt.Errorf("新旧证书 ARN 相同")
+	}
+
+	// CloudFront 只能使用 us-east-1 的证书
+	if region := RegionFromARN(toARN); region != "" && region != CloudFrontRegion {
+		return nil, fmt.Errorf("CloudFront 只能使用 %s 区域的证书，新证书位于 %s", CloudFrontRegion, region)
+	}
+
+	target, err := c.GetCertificate(ctx, toARN)
+	if err != nil {
+		return nil, err
+	}
+	if target.Status != "ISSUED" {
+		return nil, fmt.Errorf("新证书状态为 %s，只能使用已签发的证书", target.Status)
+	}
+
+	dists, err := c.ListDistributions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := append([]string{target.DomainName}, target.SubjectAltNames...)
+	plan := &RotationPlan{
+		FromARN: fromARN,
+		ToARN:   toARN,
+		Target:  target,
+	}
+	for _, dist := range dists {
+		if dist.CertificateARN != fromARN {
+			continue
+		}
+		plan.Items = append(plan.Items, RotationPlanItem{
+			Distribution: dist,
+			Uncovered:    UncoveredHosts(names, dist.Aliases),
+		})
+	}
+
+	logger.Info("证书轮换计划生成完成", "distributions", len(plan.Items))
+	return plan, nil
+}
+
+// RotateCertificate 按计划将分发的证书替换为新证书
+func (c *Client) RotateCertificate(ctx context.Context, plan *RotationPlan) []RotationResult {
+	results := make([]RotationResult, 0, len(plan.Items))
+
+	for _, item := range plan.Items {
+		id := item.Distribution.ID
+		result := RotationResult{DistributionID: id}
+
+		if err := c.ReplaceDistributionCertificate(ctx, id, plan.FromARN, plan.ToARN); err != nil {
+			logger.Error("替换分发证书失败", "id", id, "error", err)
+			result.Error = err.Error()
+		} else {
+			result.Success = true
+		}
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// ReplaceDistributionCertificate 将分发的证书从 fromARN 替换为 toARN
+// 使用 ETag 做并发控制，配置在读取后被修改时重新读取并重试
+func (c *Client) ReplaceDistributionCertificate(ctx context.Context, distributionID, fromARN, toARN string) error {
+	logger.Debug("替换分发证书", "id", distributionID, "from", fromARN, "to", toARN)
+
+	for attempt := 1; ; attempt++ {
+		err := c.replaceDistributionCertificateOnce(ctx, distributionID, fromARN, toARN)
+		if err == nil {
+			logger.Info("成功替换分发证书", "id", distributionID)
+			return nil
+		}
+
+		var precondition *types.PreconditionFailed
+		if !errors.As(err, &precondition) || attempt >= rotateMaxAttempts {
+			return err
+		}
+		logger.Warn("分发配置已被修改，重新获取后重试", "id", distributionID, "attempt", attempt)
+	}
+}
+
+// replaceDistributionCertificateOnce 读取分发配置并替换证书
+func (c *Client) replaceDistributionCertificateOnce(ctx context.Context, distributionID, fromARN, toARN string) error {
+	output, err := c.cloudfrontClient.GetDistributionConfig(ctx, &cloudfront.GetDistributionConfigInput{
+		Id: &distributionID,
+	})
+	if err != nil {
+		return fmt.Errorf("获取分发配置失败: %w", err)
+	}
+	if output.DistributionConfig == nil {
+		return fmt.Errorf("分发不存在")
+	}
+
+	config := output.DistributionConfig
+	viewer := config.ViewerCertificate
+	current := ""
+	if viewer != nil {
+		current = safeString(viewer.ACMCertificateArn)
+	}
+
+	// 已经是新证书时无需更新
+	if current == toARN {
+		logger.Info("分发已使用新证书", "id", distributionID)
+		return nil
+	}
+	if current != fromARN {
+		return fmt.Errorf("分发当前使用的证书为 %q，与旧证书不一致", current)
+	}
+
+	viewer.ACMCertificateArn = &toARN
+	viewer.CloudFrontDefaultCertificate = nil
+	viewer.IAMCertificateId = nil
+	if viewer.Certificate != nil {
+		viewer.Certificate = &toARN
+		viewer.CertificateSource = types.CertificateSourceAcm
+	}
+
+	_, err = c.cloudfrontClient.UpdateDistribution(ctx, &cloudfront.UpdateDistributionInput{
+		Id:                 &distributionID,
+		DistributionConfig: config,
+		IfMatch:            output.ETag,
+	})
+	if err != nil {
+		return fmt.Errorf("更新分发失败: %w", err)
+	}
+
+	return nil
+}
+
+// UncoveredHosts 返回未被证书域名覆盖的主机名
+func UncoveredHosts(names []string, hosts []string) []string {
+	var uncovered []string
+	for _, host := range hosts {
+		if !CertificateCoversHost(names, host) {
+			uncovered = append(uncovered, host)
+		}
+	}
+	return uncovered
+}
+
+// CertificateCoversHost 检查证书域名是否覆盖主机名
+// 通配符只匹配一级子域名，如 *.example.com 覆盖 www.example.com，但不覆盖 example.com 和 a.b.example.com
+func CertificateCoversHost(names []string, host string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSuffix(name, "."))
+		if name == host {
+			return true
+		}
+
+		if suffix, ok := strings.CutPrefix(name, "*."); ok {
+			label, rest, found := strings.Cut(host, ".")
+			if found && label != "" && rest == suffix {
+				return true
+			}
+		}
+	}
+
+	return false
+}
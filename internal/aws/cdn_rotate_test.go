@@ -0,0 +1,56 @@
+package aws
+
+import "testing"
+
+func TestCertificateCoversHost(t *testing.T) {
+	names := []string{"example.com", "*.example.com", "api.other.com"}
+
+	tests := []struct {
+		host string
+		want bool
+	}{
+		{"example.com", true},
+		{"www.example.com", true},
+		{"WWW.Example.com.", true},
+		{"a.b.example.com", false},
+		{"api.other.com", true},
+		{"other.com", false},
+		{"www.other.com", false},
+		{"badexample.com", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			if got := CertificateCoversHost(names, tt.host); got != tt.want {
+				t.Errorf("CertificateCoversHost(%q) = %v, want %v", tt.host, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUncoveredHosts(t *testing.T) {
+	names := []string{"*.example.com"}
+	hosts := []string{"www.example.com", "example.com", "cdn.example.com"}
+
+	uncovered := UncoveredHosts(names, hosts)
+	if len(uncovered) != 1 || uncovered[0] != "example.com" {
+		t.Errorf("UncoveredHosts() = %v, want [example.com]", uncovered)
+	}
+}
+
+func TestRotationPlan_Covered(t *testing.T) {
+	plan := &RotationPlan{
+		Items: []RotationPlanItem{
+			{Distribution: Distribution{ID: "E1"}},
+			{Distribution: Distribution{ID: "E2"}, Uncovered: []string{"example.com"}},
+		},
+	}
+	if plan.Covered() {
+		t.Error("存在未覆盖的域名时 Covered() 应返回 false")
+	}
+
+	plan.Items = plan.Items[:1]
+	if !plan.Covered() {
+		t.Error("所有域名都被覆盖时 Covered() 应返回 true")
+	}
+}
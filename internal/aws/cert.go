@@ -2,27 +2,48 @@ package aws
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/acm"
 	"github.com/aws/aws-sdk-go-v2/service/acm/types"
 
+	"github.com/ado1t/cloudctl/internal/config"
 	"github.com/ado1t/cloudctl/internal/logger"
 )
 
 // Certificate ACM 证书信息
 type Certificate struct {
-	ARN               string
-	DomainName        string
-	Status            string
-	Type              string
+	ARN          string
+	DomainName   string
+	Region       string
+	Status       string
+	Type         string
+	KeyAlgorithm string
+	// Serial 证书序列号（冒号分隔的十六进制）
+	Serial            string
 	InUse             bool
+	CreatedAt         time.Time
 	IssuedAt          time.Time
 	NotBefore         time.Time
 	NotAfter          time.Time
 	SubjectAltNames   []string
 	ValidationRecords []ValidationRecord
+	// RenewalStatus 托管续期状态（仅 ACM 签发的证书）
+	RenewalStatus string
+	// RenewalStatusReason 续期失败原因
+	RenewalStatusReason string
+	// ValidationStatus 域名验证的汇总状态
+	ValidationStatus string
+	// InUseBy 使用该证书的资源 ARN 列表
+	InUseBy []string
 }
 
 // ValidationRecord DNS 验证记录
@@ -37,6 +58,14 @@ type ValidationRecord struct {
 type RequestCertificateInput struct {
 	DomainName              string
 	SubjectAlternativeNames []string
+	// KeyAlgorithm 密钥算法，如 RSA_2048、EC_prime256v1（为空时使用 ACM 默认的 RSA_2048）
+	KeyAlgorithm string
+	// Tags 证书标签
+	Tags map[string]string
+	// CertificateTransparency 证书透明度日志偏好：ENABLED 或 DISABLED（为空时使用 ACM 默认值）
+	CertificateTransparency string
+	// IdempotencyToken 幂等令牌，一小时内使用相同令牌重复申请会返回同一个证书
+	IdempotencyToken string
 }
 
 // CertificatesConfig 批量证书配置
@@ -46,8 +75,14 @@ type CertificatesConfig struct {
 
 // CertificateRequest 证书申请请求
 type CertificateRequest struct {
-	Domain string   `yaml:"domain"`
-	SANs   []string `yaml:"san"`
+	Domain                  string            `yaml:"domain"`
+	SANs                    []string          `yaml:"san"`
+	KeyAlgorithm            string            `yaml:"key_algorithm"`
+	Tags                    map[string]string `yaml:"tags"`
+	CertificateTransparency string            `yaml:"certificate_transparency"`
+	IdempotencyToken        string            `yaml:"idempotency_token"`
+	// Reuse 存在 SAN 集合完全一致的已签发或待验证证书时直接复用，不再申请新证书
+	Reuse bool `yaml:"reuse"`
 }
 
 // BatchRequestResult 批量申请结果
@@ -55,6 +90,7 @@ type BatchRequestResult struct {
 	Total   int
 	Success int
 	Failed  int
+	Reused  int
 	Results []CertificateResult
 }
 
@@ -65,34 +101,111 @@ type CertificateResult struct {
 	ARN               string
 	Error             string
 	ValidationRecords []ValidationRecord
+	// Reused 是否复用了已有证书
+	Reused bool
 }
 
+// defaultValidationRecordTimeout 未配置时等待验证记录生成的最长时间
+const defaultValidationRecordTimeout = 30 * time.Second
+
+// idempotencyTokenPattern ACM 幂等令牌格式
+var idempotencyTokenPattern = regexp.MustCompile(`^\w*$`)
+
 // ListCertificates 列出所有证书
 func (c *Client) ListCertificates(ctx context.Context) ([]Certificate, error) {
-	logger.Debug("列出 ACM 证书")
+	logger.Debug("列出 ACM 证书", "region", c.region)
+
+	certificates, err := c.listCertificatesInRegion(ctx, c.region)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("成功列出证书", "count", len(certificates))
+	return certificates, nil
+}
+
+// ListCertificatesAllRegions 并发列出多个区域的证书
+// 部分区域失败时返回已成功区域的证书，同时返回汇总的错误
+func (c *Client) ListCertificatesAllRegions(ctx context.Context, regions []string, concurrency int) ([]Certificate, error) {
+	logger.Debug("列出多区域 ACM 证书", "regions", regions, "concurrency", concurrency)
+
+	if len(regions) == 0 {
+		return nil, fmt.Errorf("区域列表不能为空")
+	}
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	results := make([][]Certificate, len(regions))
+	errs := make([]error, len(regions))
+
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, concurrency)
+
+	for i, region := range regions {
+		wg.Add(1)
+		go func(index int, region string) {
+			defer wg.Done()
+
+			// 获取信号量
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			certs, err := c.listCertificatesInRegion(ctx, region)
+			if err != nil {
+				logger.Warn("列出区域证书失败", "region", region, "error", err)
+				errs[index] = fmt.Errorf("%s: %w", region, err)
+				return
+			}
+			results[index] = certs
+		}(i, region)
+	}
+
+	wg.Wait()
+
+	// 按区域顺序合并结果
+	var certificates []Certificate
+	for _, certs := range results {
+		certificates = append(certificates, certs...)
+	}
+
+	logger.Info("成功列出多区域证书", "regions", len(regions), "count", len(certificates))
+	return certificates, errors.Join(errs...)
+}
+
+// listCertificatesInRegion 分页列出指定区域的所有证书
+func (c *Client) listCertificatesInRegion(ctx context.Context, region string) ([]Certificate, error) {
+	client := c.acmClientForRegion(region)
 
 	var certificates []Certificate
 	var nextToken *string
 
 	for {
+		// 默认只返回 RSA_2048 证书，需要显式指定所有密钥算法
 		input := &acm.ListCertificatesInput{
 			NextToken: nextToken,
+			Includes: &types.Filters{
+				KeyTypes: types.KeyAlgorithm("").Values(),
+			},
 		}
 
-		output, err := c.acmClient.ListCertificates(ctx, input)
+		output, err := client.ListCertificates(ctx, input)
 		if err != nil {
 			return nil, fmt.Errorf("列出证书失败: %w", err)
 		}
 
 		for _, cert := range output.CertificateSummaryList {
 			certificates = append(certificates, Certificate{
-				ARN:        safeString(cert.CertificateArn),
-				DomainName: safeString(cert.DomainName),
-				Status:     string(cert.Status),
-				Type:       string(cert.Type),
-				InUse:      safeBool(cert.InUse),
-				NotBefore:  safeTime(cert.NotBefore),
-				NotAfter:   safeTime(cert.NotAfter),
+				ARN:          safeString(cert.CertificateArn),
+				DomainName:   safeString(cert.DomainName),
+				Region:       region,
+				Status:       string(cert.Status),
+				Type:         string(cert.Type),
+				KeyAlgorithm: string(cert.KeyAlgorithm),
+				InUse:        safeBool(cert.InUse),
+				CreatedAt:    safeTime(cert.CreatedAt),
+				NotBefore:    safeTime(cert.NotBefore),
+				NotAfter:     safeTime(cert.NotAfter),
 			})
 		}
 
@@ -103,7 +216,6 @@ func (c *Client) ListCertificates(ctx context.Context) ([]Certificate, error) {
 		nextToken = output.NextToken
 	}
 
-	logger.Info("成功列出证书", "count", len(certificates))
 	return certificates, nil
 }
 
@@ -115,7 +227,13 @@ func (c *Client) GetCertificate(ctx context.Context, certificateARN string) (*Ce
 		CertificateArn: &certificateARN,
 	}
 
-	output, err := c.acmClient.DescribeCertificate(ctx, input)
+	// 证书 ARN 中包含区域，使用对应区域的客户端
+	region := RegionFromARN(certificateARN)
+	if region == "" {
+		region = c.region
+	}
+
+	output, err := c.acmClientForRegion(region).DescribeCertificate(ctx, input)
 	if err != nil {
 		return nil, fmt.Errorf("获取证书详情失败: %w", err)
 	}
@@ -150,44 +268,61 @@ func (c *Client) GetCertificate(ctx context.Context, certificateARN string) (*Ce
 	// 检查证书是否在使用中 (通过 InUseBy 列表判断)
 	inUse := len(cert.InUseBy) > 0
 
+	// 提取续期信息
+	var renewalStatus, renewalReason string
+	if cert.RenewalSummary != nil {
+		renewalStatus = string(cert.RenewalSummary.RenewalStatus)
+		renewalReason = string(cert.RenewalSummary.RenewalStatusReason)
+	}
+
 	certificate := &Certificate{
 		ARN:               safeString(cert.CertificateArn),
 		DomainName:        safeString(cert.DomainName),
+		Region:            region,
 		Status:            string(cert.Status),
 		Type:              string(cert.Type),
+		KeyAlgorithm:      string(cert.KeyAlgorithm),
+		Serial:            safeString(cert.Serial),
 		InUse:             inUse,
+		CreatedAt:         safeTime(cert.CreatedAt),
 		IssuedAt:          safeTime(cert.IssuedAt),
 		NotBefore:         safeTime(cert.NotBefore),
 		NotAfter:          safeTime(cert.NotAfter),
 		SubjectAltNames:   sans,
 		ValidationRecords: validationRecords,
+
+		RenewalStatus:       renewalStatus,
+		RenewalStatusReason: renewalReason,
+		ValidationStatus:    aggregateValidationStatus(cert.DomainValidationOptions),
+		InUseBy:             cert.InUseBy,
 	}
 
 	logger.Info("成功获取证书详情", "arn", certificate.ARN)
 	return certificate, nil
 }
 
+// validationRecordPollConfig 生成等待验证记录的轮询配置
+// 间隔沿用 api.poll 配置，超时使用 api.poll.validation_timeout
+func validationRecordPollConfig() PollConfig {
+	cfg := DefaultPollConfig()
+	cfg.InitialDelay = cfg.Interval
+	cfg.Timeout = defaultValidationRecordTimeout
+	if c := config.Get(); c != nil && c.API.Poll.ValidationTimeout > 0 {
+		cfg.Timeout = time.Duration(c.API.Poll.ValidationTimeout) * time.Second
+	}
+	return cfg
+}
+
 // RequestCertificate 申请新证书
 func (c *Client) RequestCertificate(ctx context.Context, input *RequestCertificateInput) (*Certificate, error) {
 	logger.Debug("申请 ACM 证书", "domain", input.DomainName, "sans", input.SubjectAlternativeNames)
 
 	// 验证参数
-	if input.DomainName == "" {
-		return nil, fmt.Errorf("域名不能为空")
-	}
-
-	// 构建 SANs 列表 (包含主域名)
-	sans := []string{input.DomainName}
-	if len(input.SubjectAlternativeNames) > 0 {
-		sans = append(sans, input.SubjectAlternativeNames...)
+	if err := input.Validate(); err != nil {
+		return nil, err
 	}
 
-	// 申请证书 (仅支持 DNS 验证)
-	requestInput := &acm.RequestCertificateInput{
-		DomainName:              &input.DomainName,
-		SubjectAlternativeNames: sans,
-		ValidationMethod:        types.ValidationMethodDns,
-	}
+	requestInput := input.toACMInput()
 
 	output, err := c.acmClient.RequestCertificate(ctx, requestInput)
 	if err != nil {
@@ -201,31 +336,27 @@ func (c *Client) RequestCertificate(ctx context.Context, input *RequestCertifica
 	certificateARN := *output.CertificateArn
 	logger.Info("成功申请证书", "arn", certificateARN)
 
-	// 等待并重试获取验证记录 (AWS 生成验证记录需要时间)
+	// 等待 AWS 生成验证记录 (生成验证记录需要时间)
 	var certificate *Certificate
-	maxRetries := 5
-	for i := 0; i < maxRetries; i++ {
-		if i > 0 {
-			logger.Debug("等待 AWS 生成验证记录...", "retry", i, "max", maxRetries)
-			time.Sleep(time.Duration(2+i) * time.Second) // 递增等待时间: 2s, 3s, 4s, 5s, 6s
-		} else {
-			time.Sleep(2 * time.Second) // 首次等待 2 秒
-		}
-
+	err = Poll(ctx, validationRecordPollConfig(), func(ctx context.Context) (bool, string, error) {
 		cert, err := c.GetCertificate(ctx, certificateARN)
 		if err != nil {
-			logger.Warn("获取证书详情失败", "error", err, "retry", i+1)
-			continue
+			logger.Warn("获取证书详情失败", "error", err)
+			return false, err.Error(), nil
 		}
 
 		// 检查是否有验证记录
 		if len(cert.ValidationRecords) > 0 {
 			certificate = cert
 			logger.Info("成功获取验证记录", "count", len(cert.ValidationRecords))
-			break
+			return true, cert.Status, nil
 		}
 
-		logger.Debug("验证记录尚未生成", "retry", i+1)
+		logger.Debug("验证记录尚未生成")
+		return false, cert.Status, nil
+	})
+	if err != nil && !errors.Is(err, ErrPollTimeout) {
+		return nil, fmt.Errorf("等待验证记录失败: %w", err)
 	}
 
 	// 如果重试后仍然没有获取到验证记录,返回基本信息
@@ -250,13 +381,45 @@ func (c *Client) BatchRequestCertificates(ctx context.Context, requests []Certif
 		Results: make([]CertificateResult, 0, len(requests)),
 	}
 
+	// 需要复用时只列出一次已有证书
+	var existing []Certificate
+	for _, req := range requests {
+		if !req.Reuse {
+			continue
+		}
+		certs, err := c.ListCertificates(ctx)
+		if err != nil {
+			logger.Warn("列出已有证书失败，将不复用证书", "error", err)
+		}
+		existing = certs
+		break
+	}
+
 	for i, req := range requests {
 		logger.Info("申请证书", "progress", fmt.Sprintf("%d/%d", i+1, len(requests)), "domain", req.Domain)
 
 		// 准备申请参数
-		input := &RequestCertificateInput{
-			DomainName:              req.Domain,
-			SubjectAlternativeNames: req.SANs,
+		input := req.ToInput()
+
+		// 复用已有证书
+		if req.Reuse && len(existing) > 0 {
+			cert, err := c.FindReusableCertificate(ctx, existing, input)
+			if err != nil {
+				logger.Warn("查找可复用证书失败", "domain", req.Domain, "error", err)
+			}
+			if cert != nil {
+				result.Success++
+				result.Reused++
+				result.Results = append(result.Results, CertificateResult{
+					Domain:            req.Domain,
+					Success:           true,
+					ARN:               cert.ARN,
+					ValidationRecords: cert.ValidationRecords,
+					Reused:            true,
+				})
+				logger.Info("复用已有证书", "domain", req.Domain, "arn", cert.ARN)
+				continue
+			}
 		}
 
 		// 申请证书
@@ -283,6 +446,175 @@ func (c *Client) BatchRequestCertificates(ctx context.Context, requests []Certif
 		logger.Info("成功申请证书", "domain", req.Domain, "arn", cert.ARN)
 	}
 
-	logger.Info("批量申请完成", "total", result.Total, "success", result.Success, "failed", result.Failed)
+	logger.Info("批量申请完成", "total", result.Total, "success", result.Success, "failed", result.Failed, "reused", result.Reused)
 	return result
 }
+
+// WaitForCertificateIssued 等待证书签发完成
+// 证书进入 FAILED、VALIDATION_TIMED_OUT 等终止状态时立即返回错误
+func (c *Client) WaitForCertificateIssued(ctx context.Context, certificateARN string, cfg PollConfig) (*Certificate, error) {
+	logger.Debug("等待证书签发", "arn", certificateARN)
+
+	var certificate *Certificate
+	err := Poll(ctx, cfg, func(ctx context.Context) (bool, string, error) {
+		cert, err := c.GetCertificate(ctx, certificateARN)
+		if err != nil {
+			return false, "", err
+		}
+		certificate = cert
+
+		switch types.CertificateStatus(cert.Status) {
+		case types.CertificateStatusIssued:
+			return true, cert.Status, nil
+		case types.CertificateStatusPendingValidation:
+			return false, cert.Status, nil
+		default:
+			return false, cert.Status, fmt.Errorf("证书状态为 %s，无法完成签发", cert.Status)
+		}
+	})
+	if err != nil {
+		return certificate, err
+	}
+
+	logger.Info("证书已签发", "arn", certificateARN)
+	return certificate, nil
+}
+
+// aggregateValidationStatus 汇总所有域名的验证状态
+// 任一域名验证失败则为 FAILED，存在待验证的域名则为 PENDING_VALIDATION，全部成功为 SUCCESS
+func aggregateValidationStatus(options []types.DomainValidation) string {
+	if len(options) == 0 {
+		return ""
+	}
+
+	status := string(types.DomainStatusSuccess)
+	for _, opt := range options {
+		switch opt.ValidationStatus {
+		case types.DomainStatusFailed:
+			return string(types.DomainStatusFailed)
+		case types.DomainStatusPendingValidation:
+			status = string(types.DomainStatusPendingValidation)
+		}
+	}
+	return status
+}
+
+// Validate 验证申请参数
+func (in *RequestCertificateInput) Validate() error {
+	if in.DomainName == "" {
+		return fmt.Errorf("域名不能为空")
+	}
+
+	if in.KeyAlgorithm != "" && !isValidEnum(in.KeyAlgorithm, types.KeyAlgorithm("").Values()) {
+		return fmt.Errorf("无效的密钥算法: %s", in.KeyAlgorithm)
+	}
+
+	if in.CertificateTransparency != "" &&
+		!isValidEnum(strings.ToUpper(in.CertificateTransparency), types.CertificateTransparencyLoggingPreference("").Values()) {
+		return fmt.Errorf("无效的证书透明度日志偏好: %s（可选值: ENABLED, DISABLED）", in.CertificateTransparency)
+	}
+
+	if len(in.IdempotencyToken) > 32 || !idempotencyTokenPattern.MatchString(in.IdempotencyToken) {
+		return fmt.Errorf("无效的幂等令牌: %s（最多 32 个字母、数字或下划线）", in.IdempotencyToken)
+	}
+
+	return nil
+}
+
+// toACMInput 转换为 ACM 请求参数 (仅支持 DNS 验证)
+func (in *RequestCertificateInput) toACMInput() *acm.RequestCertificateInput {
+	requestInput := &acm.RequestCertificateInput{
+		DomainName:              &in.DomainName,
+		SubjectAlternativeNames: normalizeSANs(in.DomainName, in.SubjectAlternativeNames),
+		ValidationMethod:        types.ValidationMethodDns,
+	}
+
+	if in.KeyAlgorithm != "" {
+		requestInput.KeyAlgorithm = types.KeyAlgorithm(in.KeyAlgorithm)
+	}
+
+	if in.CertificateTransparency != "" {
+		requestInput.Options = &types.CertificateOptions{
+			CertificateTransparencyLoggingPreference: types.CertificateTransparencyLoggingPreference(strings.ToUpper(in.CertificateTransparency)),
+		}
+	}
+
+	if in.IdempotencyToken != "" {
+		requestInput.IdempotencyToken = &in.IdempotencyToken
+	}
+
+	// 按 key 排序，保证请求可重现
+	keys := make([]string, 0, len(in.Tags))
+	for key := range in.Tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value := in.Tags[key]
+		requestInput.Tags = append(requestInput.Tags, types.Tag{Key: &key, Value: &value})
+	}
+
+	return requestInput
+}
+
+// ToInput 将配置文件中的申请请求转换为申请参数
+// 未指定幂等令牌时根据申请内容生成确定性的令牌，避免重复执行时创建重复证书
+func (r CertificateRequest) ToInput() *RequestCertificateInput {
+	input := &RequestCertificateInput{
+		DomainName:              r.Domain,
+		SubjectAlternativeNames: r.SANs,
+		KeyAlgorithm:            r.KeyAlgorithm,
+		Tags:                    r.Tags,
+		CertificateTransparency: r.CertificateTransparency,
+		IdempotencyToken:        r.IdempotencyToken,
+	}
+
+	if input.IdempotencyToken == "" {
+		input.IdempotencyToken = DeriveIdempotencyToken(input)
+	}
+
+	return input
+}
+
+// DeriveIdempotencyToken 根据域名、SAN、密钥算法和证书透明度偏好生成幂等令牌
+func DeriveIdempotencyToken(input *RequestCertificateInput) string {
+	sans := normalizeSANs(input.DomainName, input.SubjectAlternativeNames)
+	sort.Strings(sans)
+
+	parts := []string{
+		strings.ToLower(input.DomainName),
+		strings.Join(sans, ","),
+		input.KeyAlgorithm,
+		strings.ToUpper(input.CertificateTransparency),
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(parts, "|")))
+	return hex.EncodeToString(sum[:])[:32]
+}
+
+// normalizeSANs 构建 SAN 列表：主域名在首位，统一小写、去除尾部的点并去重
+func normalizeSANs(domain string, sans []string) []string {
+	seen := make(map[string]bool, len(sans)+1)
+	result := make([]string, 0, len(sans)+1)
+
+	for _, name := range append([]string{domain}, sans...) {
+		name = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(name), "."))
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		result = append(result, name)
+	}
+
+	return result
+}
+
+// isValidEnum 检查值是否为 SDK 定义的枚举值之一
+func isValidEnum[T ~string](value string, values []T) bool {
+	for _, v := range values {
+		if string(v) == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	"github.com/aws/aws-sdk-go-v2/service/acm/types"
+
+	"github.com/ado1t/cloudctl/internal/logger"
+)
+
+// DeleteCertificate 删除证书
+// 删除前会重新检查证书是否被使用，被使用的证书拒绝删除并列出使用方
+func (c *Client) DeleteCertificate(ctx context.Context, certificateARN string) error {
+	logger.Debug("删除 ACM 证书", "arn", certificateARN)
+
+	cert, err := c.GetCertificate(ctx, certificateARN)
+	if err != nil {
+		return err
+	}
+
+	if cert.InUse {
+		return fmt.Errorf("证书正在被使用，拒绝删除: %s", strings.Join(cert.InUseBy, ", "))
+	}
+
+	_, err = c.acmClientForRegion(cert.Region).DeleteCertificate(ctx, &acm.DeleteCertificateInput{
+		CertificateArn: &certificateARN,
+	})
+	if err != nil {
+		return fmt.Errorf("删除证书失败: %w", err)
+	}
+
+	logger.Info("成功删除证书", "arn", certificateARN)
+	return nil
+}
+
+// ParseCertificateStatuses 解析并校验证书状态列表
+func ParseCertificateStatuses(values []string) ([]string, error) {
+	valid := make(map[string]bool)
+	for _, status := range types.CertificateStatus("").Values() {
+		valid[string(status)] = true
+	}
+
+	statuses := make([]string, 0, len(values))
+	for _, value := range values {
+		status := strings.ToUpper(strings.TrimSpace(value))
+		if status == "" {
+			continue
+		}
+		if !valid[status] {
+			return nil, fmt.Errorf("无效的证书状态: %s", value)
+		}
+		statuses = append(statuses, status)
+	}
+
+	if len(statuses) == 0 {
+		return nil, fmt.Errorf("至少需要指定一个证书状态")
+	}
+	return statuses, nil
+}
+
+// SelectPruneCandidates 从证书列表中筛选可清理的证书
+// 匹配指定状态且创建时间早于 now-olderThan 的证书为候选，其中被使用的证书单独返回
+func SelectPruneCandidates(certs []Certificate, statuses []string, olderThan time.Duration, now time.Time) (candidates []Certificate, inUse []Certificate) {
+	statusSet := make(map[string]bool, len(statuses))
+	for _, status := range statuses {
+		statusSet[status] = true
+	}
+
+	cutoff := now.Add(-olderThan)
+	for _, cert := range certs {
+		if !statusSet[cert.Status] {
+			continue
+		}
+		// 无法确定创建时间的证书不清理
+		if cert.CreatedAt.IsZero() || cert.CreatedAt.After(cutoff) {
+			continue
+		}
+		if cert.InUse {
+			inUse = append(inUse, cert)
+			continue
+		}
+		candidates = append(candidates, cert)
+	}
+
+	return candidates, inUse
+}
@@ -0,0 +1,55 @@
+package aws
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCertificateStatuses(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []string
+		want    int
+		wantErr bool
+	}{
+		{"有效状态", []string{"FAILED", "EXPIRED", "VALIDATION_TIMED_OUT"}, 3, false},
+		{"小写与空格", []string{" failed ", "expired"}, 2, false},
+		{"无效状态", []string{"FAILED", "UNKNOWN"}, 0, true},
+		{"空列表", []string{""}, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statuses, err := ParseCertificateStatuses(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseCertificateStatuses() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(statuses) != tt.want {
+				t.Errorf("len = %d, want %d", len(statuses), tt.want)
+			}
+		})
+	}
+}
+
+func TestSelectPruneCandidates(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	old := now.Add(-60 * 24 * time.Hour)
+	recent := now.Add(-5 * 24 * time.Hour)
+
+	certs := []Certificate{
+		{ARN: "arn:failed-old", Status: "FAILED", CreatedAt: old},
+		{ARN: "arn:failed-recent", Status: "FAILED", CreatedAt: recent},
+		{ARN: "arn:expired-in-use", Status: "EXPIRED", CreatedAt: old, InUse: true},
+		{ARN: "arn:issued-old", Status: "ISSUED", CreatedAt: old},
+		{ARN: "arn:timed-out-no-date", Status: "VALIDATION_TIMED_OUT"},
+	}
+
+	candidates, inUse := SelectPruneCandidates(certs, []string{"FAILED", "EXPIRED", "VALIDATION_TIMED_OUT"}, 30*24*time.Hour, now)
+
+	if len(candidates) != 1 || candidates[0].ARN != "arn:failed-old" {
+		t.Errorf("candidates = %+v, want [arn:failed-old]", candidates)
+	}
+	if len(inUse) != 1 || inUse[0].ARN != "arn:expired-in-use" {
+		t.Errorf("inUse = %+v, want [arn:expired-in-use]", inUse)
+	}
+}
@@ -0,0 +1,271 @@
+package aws
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	"github.com/aws/aws-sdk-go-v2/service/acm/types"
+
+	"github.com/ado1t/cloudctl/internal/logger"
+)
+
+// ImportCertificateInput 导入证书的输入参数
+type ImportCertificateInput struct {
+	// CertificatePEM 证书内容（PEM 格式）
+	CertificatePEM []byte
+	// PrivateKeyPEM 私钥内容（PEM 格式，不能加密）
+	PrivateKeyPEM []byte
+	// ChainPEM 证书链内容（PEM 格式，可选）
+	ChainPEM []byte
+	// CertificateARN 重新导入到已有证书的 ARN（可选，用于续期）
+	CertificateARN string
+}
+
+// CertificateBundle 本地解析后的证书包
+type CertificateBundle struct {
+	Leaf  *x509.Certificate
+	Chain []*x509.Certificate
+
+	certificatePEM []byte
+	chainPEM       []byte
+}
+
+// ImportCertificateResult 导入证书结果
+type ImportCertificateResult struct {
+	ARN       string
+	Domain    string
+	SANs      []string
+	Issuer    string
+	NotBefore time.Time
+	NotAfter  time.Time
+	Reimport  bool
+}
+
+// ValidateCertificateBundle 解析并校验证书包
+// 校验内容：私钥与证书匹配、证书链顺序、有效期以及 SAN
+func ValidateCertificateBundle(certPEM, keyPEM, chainPEM []byte, now time.Time) (*CertificateBundle, error) {
+	certs, err := parseCertificates(certPEM)
+	if err != nil {
+		return nil, fmt.Errorf("解析证书失败: %w", err)
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("证书文件中没有找到 CERTIFICATE 块")
+	}
+
+	// 证书文件中可能包含完整链（fullchain），第一个为叶子证书，其余视为中间证书
+	leaf := certs[0]
+	chain := certs[1:]
+
+	if len(chainPEM) > 0 {
+		extra, err := parseCertificates(chainPEM)
+		if err != nil {
+			return nil, fmt.Errorf("解析证书链失败: %w", err)
+		}
+		chain = append(chain, extra...)
+	}
+
+	// 校验私钥
+	key, err := parsePrivateKey(keyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("解析私钥失败: %w", err)
+	}
+	if !publicKeyMatches(leaf.PublicKey, key) {
+		return nil, fmt.Errorf("私钥与证书不匹配")
+	}
+
+	// 校验有效期
+	if now.Before(leaf.NotBefore) {
+		return nil, fmt.Errorf("证书尚未生效 (生效时间: %s)", leaf.NotBefore.Format(time.RFC3339))
+	}
+	if now.After(leaf.NotAfter) {
+		return nil, fmt.Errorf("证书已过期 (过期时间: %s)", leaf.NotAfter.Format(time.RFC3339))
+	}
+
+	// 校验 SAN
+	if len(leaf.DNSNames) == 0 {
+		return nil, fmt.Errorf("证书不包含任何 SAN 域名")
+	}
+
+	// 校验证书链顺序：每个证书都必须由其后一个证书签发
+	issued := leaf
+	for i, parent := range chain {
+		if err := issued.CheckSignatureFrom(parent); err != nil {
+			return nil, fmt.Errorf("证书链顺序错误: 第 %d 个证书 (%s) 不是 %s 的签发者", i+1, parent.Subject.CommonName, issued.Subject.CommonName)
+		}
+		if now.After(parent.NotAfter) {
+			return nil, fmt.Errorf("证书链中的证书已过期: %s", parent.Subject.CommonName)
+		}
+		issued = parent
+	}
+
+	bundle := &CertificateBundle{
+		Leaf:           leaf,
+		Chain:          chain,
+		certificatePEM: encodeCertificates([]*x509.Certificate{leaf}),
+	}
+	if len(chain) > 0 {
+		bundle.chainPEM = encodeCertificates(chain)
+	}
+
+	return bundle, nil
+}
+
+// ImportCertificate 导入第三方证书到 ACM
+// 指定 CertificateARN 时重新导入到已有证书（续期），要求原证书为导入类型且新证书覆盖原域名
+func (c *Client) ImportCertificate(ctx context.Context, input *ImportCertificateInput) (*ImportCertificateResult, error) {
+	logger.Debug("导入 ACM 证书", "arn", input.CertificateARN)
+
+	bundle, err := ValidateCertificateBundle(input.CertificatePEM, input.PrivateKeyPEM, input.ChainPEM, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	leaf := bundle.Leaf
+	reimport := input.CertificateARN != ""
+
+	// 重新导入前检查已有证书
+	if reimport {
+		existing, err := c.GetCertificate(ctx, input.CertificateARN)
+		if err != nil {
+			return nil, err
+		}
+		if existing.Type != string(types.CertificateTypeImported) {
+			return nil, fmt.Errorf("证书 %s 的类型为 %s，只能重新导入 IMPORTED 类型的证书", input.CertificateARN, existing.Type)
+		}
+		if !containsDomain(leaf.DNSNames, existing.DomainName) {
+			return nil, fmt.Errorf("新证书未包含原证书的域名 %s", existing.DomainName)
+		}
+	}
+
+	importInput := &acm.ImportCertificateInput{
+		Certificate: bundle.certificatePEM,
+		PrivateKey:  input.PrivateKeyPEM,
+	}
+	if len(bundle.chainPEM) > 0 {
+		importInput.CertificateChain = bundle.chainPEM
+	}
+	if reimport {
+		importInput.CertificateArn = &input.CertificateARN
+	}
+
+	// 重新导入时使用原证书所在区域
+	client := c.acmClient
+	if reimport {
+		client = c.acmClientForRegion(RegionFromARN(input.CertificateARN))
+	}
+
+	output, err := client.ImportCertificate(ctx, importInput)
+	if err != nil {
+		return nil, fmt.Errorf("导入证书失败: %w", err)
+	}
+
+	result := &ImportCertificateResult{
+		ARN:       safeString(output.CertificateArn),
+		Domain:    leaf.Subject.CommonName,
+		SANs:      leaf.DNSNames,
+		Issuer:    leaf.Issuer.CommonName,
+		NotBefore: leaf.NotBefore,
+		NotAfter:  leaf.NotAfter,
+		Reimport:  reimport,
+	}
+	if result.Domain == "" {
+		result.Domain = leaf.DNSNames[0]
+	}
+
+	logger.Info("成功导入证书", "arn", result.ARN, "reimport", reimport)
+	return result, nil
+}
+
+// parseCertificates 解析 PEM 中的所有证书
+func parseCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	return certs, nil
+}
+
+// parsePrivateKey 解析 PEM 私钥，支持 PKCS#1、PKCS#8 和 EC 格式
+func parsePrivateKey(data []byte) (crypto.Signer, error) {
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return nil, fmt.Errorf("没有找到私钥")
+		}
+		if !strings.Contains(block.Type, "PRIVATE KEY") {
+			continue
+		}
+		if block.Type == "ENCRYPTED PRIVATE KEY" || block.Headers["Proc-Type"] != "" {
+			return nil, fmt.Errorf("ACM 不支持加密的私钥")
+		}
+
+		if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+			return key, nil
+		}
+		if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+			return key, nil
+		}
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("不支持的私钥类型: %T", key)
+		}
+		return signer, nil
+	}
+}
+
+// publicKeyMatches 检查证书公钥与私钥是否匹配
+func publicKeyMatches(pub crypto.PublicKey, key crypto.Signer) bool {
+	switch k := key.Public().(type) {
+	case *rsa.PublicKey:
+		return k.Equal(pub)
+	case *ecdsa.PublicKey:
+		return k.Equal(pub)
+	default:
+		return false
+	}
+}
+
+// encodeCertificates 将证书重新编码为 PEM
+func encodeCertificates(certs []*x509.Certificate) []byte {
+	var buf bytes.Buffer
+	for _, cert := range certs {
+		pem.Encode(&buf, &pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+	}
+	return buf.Bytes()
+}
+
+// containsDomain 检查域名列表是否包含指定域名（忽略大小写）
+func containsDomain(names []string, domain string) bool {
+	for _, name := range names {
+		if strings.EqualFold(name, domain) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,169 @@
+package aws
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testCert 测试用证书及其私钥
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+// newTestCert 生成测试证书，parent 为 nil 时生成自签名 CA
+func newTestCert(t *testing.T, cn string, dnsNames []string, isCA bool, notBefore, notAfter time.Time, parent *testCert) *testCert {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("生成私钥失败: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: cn},
+		DNSNames:              dnsNames,
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+
+	issuerCert, issuerKey := template, key
+	if parent != nil {
+		issuerCert, issuerKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, issuerCert, &key.PublicKey, issuerKey)
+	if err != nil {
+		t.Fatalf("生成证书失败: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("解析证书失败: %v", err)
+	}
+
+	return &testCert{
+		cert: cert,
+		key:  key,
+		pem:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+// keyPEM 编码私钥
+func (c *testCert) keyPEM(t *testing.T) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(c.key)
+	if err != nil {
+		t.Fatalf("编码私钥失败: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func TestValidateCertificateBundle(t *testing.T) {
+	now := time.Now()
+	validFrom, validTo := now.Add(-time.Hour), now.Add(90*24*time.Hour)
+
+	root := newTestCert(t, "Test Root", nil, true, validFrom, validTo, nil)
+	intermediate := newTestCert(t, "Test Intermediate", nil, true, validFrom, validTo, root)
+	leaf := newTestCert(t, "example.com", []string{"example.com", "www.example.com"}, false, validFrom, validTo, intermediate)
+	other := newTestCert(t, "other.com", []string{"other.com"}, false, validFrom, validTo, intermediate)
+	expired := newTestCert(t, "expired.com", []string{"expired.com"}, false, now.Add(-48*time.Hour), now.Add(-24*time.Hour), intermediate)
+	noSAN := newTestCert(t, "nosan.com", nil, false, validFrom, validTo, intermediate)
+
+	chain := append(append([]byte{}, intermediate.pem...), root.pem...)
+	reversed := append(append([]byte{}, root.pem...), intermediate.pem...)
+
+	tests := []struct {
+		name        string
+		cert        []byte
+		key         []byte
+		chain       []byte
+		wantErr     bool
+		errContains string
+		chainLen    int
+	}{
+		{
+			name:     "有效证书 - 完整证书链",
+			cert:     leaf.pem,
+			key:      leaf.keyPEM(t),
+			chain:    chain,
+			chainLen: 2,
+		},
+		{
+			name:     "有效证书 - fullchain 文件",
+			cert:     append(append([]byte{}, leaf.pem...), intermediate.pem...),
+			key:      leaf.keyPEM(t),
+			chainLen: 1,
+		},
+		{
+			name:        "私钥不匹配",
+			cert:        leaf.pem,
+			key:         other.keyPEM(t),
+			chain:       chain,
+			wantErr:     true,
+			errContains: "不匹配",
+		},
+		{
+			name:        "证书链顺序错误",
+			cert:        leaf.pem,
+			key:         leaf.keyPEM(t),
+			chain:       reversed,
+			wantErr:     true,
+			errContains: "顺序错误",
+		},
+		{
+			name:        "证书已过期",
+			cert:        expired.pem,
+			key:         expired.keyPEM(t),
+			wantErr:     true,
+			errContains: "已过期",
+		},
+		{
+			name:        "缺少 SAN",
+			cert:        noSAN.pem,
+			key:         noSAN.keyPEM(t),
+			wantErr:     true,
+			errContains: "SAN",
+		},
+		{
+			name:        "证书内容为空",
+			cert:        []byte("not a pem"),
+			key:         leaf.keyPEM(t),
+			wantErr:     true,
+			errContains: "CERTIFICATE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bundle, err := ValidateCertificateBundle(tt.cert, tt.key, tt.chain, now)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("应该返回错误")
+				}
+				if !strings.Contains(err.Error(), tt.errContains) {
+					t.Errorf("错误信息 = %q, 应包含 %q", err.Error(), tt.errContains)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("不应返回错误: %v", err)
+			}
+			if len(bundle.Chain) != tt.chainLen {
+				t.Errorf("证书链长度 = %d, want %d", len(bundle.Chain), tt.chainLen)
+			}
+		})
+	}
+}
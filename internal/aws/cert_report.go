@@ -0,0 +1,113 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/acm/types"
+
+	"github.com/ado1t/cloudctl/internal/logger"
+)
+
+// CertificateReportItem 证书到期报告条目
+type CertificateReportItem struct {
+	Certificate
+	// DaysRemaining 距离到期的剩余天数（已过期为负数）
+	DaysRemaining int
+	// Breached 是否触发告警
+	Breached bool
+	// Reasons 触发告警的原因
+	Reasons []string
+}
+
+// CertificateReport 证书到期报告
+type CertificateReport struct {
+	Threshold time.Duration
+	Items     []CertificateReportItem
+	Breached  int
+}
+
+// GenerateCertificateReport 生成证书到期与续期报告
+// 先列出所有证书，再逐个获取详情以补充续期状态
+func (c *Client) GenerateCertificateReport(ctx context.Context, threshold time.Duration) (*CertificateReport, error) {
+	logger.Debug("生成证书到期报告", "threshold", threshold)
+
+	summaries, err := c.ListCertificates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	certs := make([]Certificate, 0, len(summaries))
+	for _, summary := range summaries {
+		// 尚未签发的证书没有有效期，不纳入报告
+		if summary.NotAfter.IsZero() {
+			continue
+		}
+
+		cert, err := c.GetCertificate(ctx, summary.ARN)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, *cert)
+	}
+
+	report := BuildCertificateReport(certs, threshold, time.Now())
+	logger.Info("证书到期报告生成完成", "total", len(report.Items), "breached", report.Breached)
+	return report, nil
+}
+
+// BuildCertificateReport 根据阈值计算每个证书的剩余天数和告警状态
+// 以下情况视为告警：剩余有效期不超过阈值（含已过期）、续期失败、续期卡在待验证状态
+func BuildCertificateReport(certs []Certificate, threshold time.Duration, now time.Time) *CertificateReport {
+	report := &CertificateReport{
+		Threshold: threshold,
+		Items:     make([]CertificateReportItem, 0, len(certs)),
+	}
+
+	for _, cert := range certs {
+		item := CertificateReportItem{
+			Certificate:   cert,
+			DaysRemaining: DaysUntil(now, cert.NotAfter),
+		}
+
+		remaining := cert.NotAfter.Sub(now)
+		switch {
+		case remaining <= 0:
+			item.Reasons = append(item.Reasons, "已过期")
+		case remaining <= threshold:
+			item.Reasons = append(item.Reasons, fmt.Sprintf("%d 天内到期", item.DaysRemaining))
+		}
+
+		switch types.RenewalStatus(cert.RenewalStatus) {
+		case types.RenewalStatusFailed:
+			item.Reasons = append(item.Reasons, "续期失败")
+		case types.RenewalStatusPendingValidation:
+			item.Reasons = append(item.Reasons, "续期等待验证")
+		}
+
+		if cert.RenewalStatusReason != "" && cert.RenewalStatus == string(types.RenewalStatusFailed) {
+			item.Reasons = append(item.Reasons, cert.RenewalStatusReason)
+		}
+
+		item.Breached = len(item.Reasons) > 0
+		if item.Breached {
+			report.Breached++
+		}
+		report.Items = append(report.Items, item)
+	}
+
+	// 按剩余天数升序排列，最紧急的排在前面
+	sort.SliceStable(report.Items, func(i, j int) bool {
+		return report.Items[i].DaysRemaining < report.Items[j].DaysRemaining
+	})
+
+	return report
+}
+
+// DaysUntil 计算从 now 到 t 的天数，不足一天向下取整
+func DaysUntil(now, t time.Time) int {
+	return int(math.Floor(t.Sub(now).Hours() / 24))
+}
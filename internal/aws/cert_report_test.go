@@ -0,0 +1,105 @@
+package aws
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuildCertificateReport(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	threshold := 30 * 24 * time.Hour
+
+	certs := []Certificate{
+		{
+			ARN:      "arn:healthy",
+			NotAfter: now.Add(90 * 24 * time.Hour),
+		},
+		{
+			ARN:      "arn:expiring",
+			NotAfter: now.Add(10*24*time.Hour + time.Hour),
+		},
+		{
+			ARN:      "arn:expired",
+			NotAfter: now.Add(-48 * time.Hour),
+		},
+		{
+			ARN:                 "arn:renewal-failed",
+			NotAfter:            now.Add(60 * 24 * time.Hour),
+			RenewalStatus:       "FAILED",
+			RenewalStatusReason: "CAA_ERROR",
+		},
+		{
+			ARN:           "arn:renewal-pending",
+			NotAfter:      now.Add(45 * 24 * time.Hour),
+			RenewalStatus: "PENDING_VALIDATION",
+		},
+	}
+
+	report := BuildCertificateReport(certs, threshold, now)
+
+	if report.Breached != 4 {
+		t.Errorf("Breached = %d, want 4", report.Breached)
+	}
+
+	wantOrder := []string{"arn:expired", "arn:expiring", "arn:renewal-pending", "arn:renewal-failed", "arn:healthy"}
+	for i, want := range wantOrder {
+		if report.Items[i].ARN != want {
+			t.Errorf("Items[%d].ARN = %s, want %s", i, report.Items[i].ARN, want)
+		}
+	}
+
+	tests := []struct {
+		arn      string
+		days     int
+		breached bool
+	}{
+		{"arn:healthy", 90, false},
+		{"arn:expiring", 10, true},
+		{"arn:expired", -2, true},
+		{"arn:renewal-failed", 60, true},
+		{"arn:renewal-pending", 45, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.arn, func(t *testing.T) {
+			var item *CertificateReportItem
+			for i := range report.Items {
+				if report.Items[i].ARN == tt.arn {
+					item = &report.Items[i]
+				}
+			}
+			if item == nil {
+				t.Fatalf("报告中缺少证书 %s", tt.arn)
+			}
+			if item.DaysRemaining != tt.days {
+				t.Errorf("DaysRemaining = %d, want %d", item.DaysRemaining, tt.days)
+			}
+			if item.Breached != tt.breached {
+				t.Errorf("Breached = %v, want %v (reasons: %v)", item.Breached, tt.breached, item.Reasons)
+			}
+		})
+	}
+}
+
+func TestDaysUntil(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		t    time.Time
+		want int
+	}{
+		{"不足一天", now.Add(23 * time.Hour), 0},
+		{"刚好一天", now.Add(24 * time.Hour), 1},
+		{"已过期不足一天", now.Add(-time.Hour), -1},
+		{"已过期两天", now.Add(-36 * time.Hour), -2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DaysUntil(now, tt.t); got != tt.want {
+				t.Errorf("DaysUntil() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package aws
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/acm/types"
+
+	"github.com/ado1t/cloudctl/internal/logger"
+)
+
+// FindReusableCertificate 在已有证书中查找可复用的证书
+// 只考虑 ISSUED 和 PENDING_VALIDATION 状态的证书，SAN 集合（含主域名）必须完全一致；
+// 申请参数指定了密钥算法时，密钥算法也必须一致。优先返回已签发的证书，未找到时返回 nil
+func (c *Client) FindReusableCertificate(ctx context.Context, certs []Certificate, input *RequestCertificateInput) (*Certificate, error) {
+	logger.Debug("查找可复用的证书", "domain", input.DomainName)
+
+	wantSANs := normalizeSANs(input.DomainName, input.SubjectAlternativeNames)
+
+	for _, candidate := range reuseCandidates(certs, input.DomainName) {
+		if input.KeyAlgorithm != "" && candidate.KeyAlgorithm != "" && candidate.KeyAlgorithm != input.KeyAlgorithm {
+			continue
+		}
+
+		// 列表接口返回的 SAN 可能不完整，需要获取详情比对
+		cert, err := c.GetCertificate(ctx, candidate.ARN)
+		if err != nil {
+			return nil, err
+		}
+
+		if sameSANSet(wantSANs, normalizeSANs(cert.DomainName, cert.SubjectAltNames)) {
+			logger.Info("找到可复用的证书", "domain", input.DomainName, "arn", cert.ARN, "status", cert.Status)
+			return cert, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// reuseCandidates 筛选主域名相同且状态可复用的证书，已签发的证书排在前面
+func reuseCandidates(certs []Certificate, domain string) []Certificate {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+
+	var candidates []Certificate
+	for _, cert := range certs {
+		if strings.ToLower(strings.TrimSuffix(cert.DomainName, ".")) != domain {
+			continue
+		}
+		if cert.Status != string(types.CertificateStatusIssued) && cert.Status != string(types.CertificateStatusPendingValidation) {
+			continue
+		}
+		candidates = append(candidates, cert)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Status == string(types.CertificateStatusIssued) &&
+			candidates[j].Status != string(types.CertificateStatusIssued)
+	})
+
+	return candidates
+}
+
+// sameSANSet 比较两个已规范化的 SAN 列表是否包含完全相同的域名（忽略顺序）
+func sameSANSet(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	set := make(map[string]bool, len(a))
+	for _, name := range a {
+		set[name] = true
+	}
+	for _, name := range b {
+		if !set[name] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,73 @@
+package aws
+
+import "testing"
+
+func TestSameSANSet(t *testing.T) {
+	tests := []struct {
+		name string
+		a    []string
+		b    []string
+		want bool
+	}{
+		{
+			name: "顺序不同",
+			a:    normalizeSANs("example.com", []string{"www.example.com", "*.example.com"}),
+			b:    normalizeSANs("example.com", []string{"*.example.com", "www.example.com"}),
+			want: true,
+		},
+		{
+			name: "大小写与尾部的点",
+			a:    normalizeSANs("Example.com.", []string{"WWW.example.com"}),
+			b:    normalizeSANs("example.com", []string{"www.example.com."}),
+			want: true,
+		},
+		{
+			name: "主域名重复出现在 SAN 中",
+			a:    normalizeSANs("example.com", []string{"example.com", "www.example.com"}),
+			b:    normalizeSANs("example.com", []string{"www.example.com"}),
+			want: true,
+		},
+		{
+			name: "已有证书多一个 SAN",
+			a:    normalizeSANs("example.com", []string{"www.example.com"}),
+			b:    normalizeSANs("example.com", []string{"www.example.com", "api.example.com"}),
+			want: false,
+		},
+		{
+			name: "SAN 不同",
+			a:    normalizeSANs("example.com", []string{"www.example.com"}),
+			b:    normalizeSANs("example.com", []string{"api.example.com"}),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sameSANSet(tt.a, tt.b); got != tt.want {
+				t.Errorf("sameSANSet(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReuseCandidates(t *testing.T) {
+	certs := []Certificate{
+		{ARN: "arn:pending", DomainName: "example.com", Status: "PENDING_VALIDATION"},
+		{ARN: "arn:expired", DomainName: "example.com", Status: "EXPIRED"},
+		{ARN: "arn:other", DomainName: "other.com", Status: "ISSUED"},
+		{ARN: "arn:issued", DomainName: "Example.com", Status: "ISSUED"},
+		{ARN: "arn:failed", DomainName: "example.com", Status: "FAILED"},
+	}
+
+	candidates := reuseCandidates(certs, "example.com")
+
+	want := []string{"arn:issued", "arn:pending"}
+	if len(candidates) != len(want) {
+		t.Fatalf("candidates = %+v, want %v", candidates, want)
+	}
+	for i, arn := range want {
+		if candidates[i].ARN != arn {
+			t.Errorf("candidates[%d] = %s, want %s", i, candidates[i].ARN, arn)
+		}
+	}
+}
@@ -2,6 +2,9 @@ package aws
 
 import (
 	"testing"
+	"time"
+
+	"github.com/ado1t/cloudctl/internal/config"
 )
 
 func TestRequestCertificateInput_Validate(t *testing.T) {
@@ -233,3 +236,107 @@ func TestSubjectAlternativeNames(t *testing.T) {
 		})
 	}
 }
+
+func TestRequestCertificateInput_ValidateOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   RequestCertificateInput
+		wantErr bool
+	}{
+		{"默认选项", RequestCertificateInput{DomainName: "example.com"}, false},
+		{"EC 密钥", RequestCertificateInput{DomainName: "example.com", KeyAlgorithm: "EC_prime256v1"}, false},
+		{"RSA_4096", RequestCertificateInput{DomainName: "example.com", KeyAlgorithm: "RSA_4096"}, false},
+		{"无效密钥算法", RequestCertificateInput{DomainName: "example.com", KeyAlgorithm: "RSA_9999"}, true},
+		{"关闭透明度日志", RequestCertificateInput{DomainName: "example.com", CertificateTransparency: "disabled"}, false},
+		{"无效透明度偏好", RequestCertificateInput{DomainName: "example.com", CertificateTransparency: "maybe"}, true},
+		{"有效幂等令牌", RequestCertificateInput{DomainName: "example.com", IdempotencyToken: "deploy_2025"}, false},
+		{"幂等令牌含非法字符", RequestCertificateInput{DomainName: "example.com", IdempotencyToken: "deploy-2025"}, true},
+		{"幂等令牌过长", RequestCertificateInput{DomainName: "example.com", IdempotencyToken: "abcdefghijklmnopqrstuvwxyz0123456789"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRequestCertificateInput_ToACMInput(t *testing.T) {
+	input := &RequestCertificateInput{
+		DomainName:              "example.com",
+		SubjectAlternativeNames: []string{"example.com", "*.Example.com", "www.example.com."},
+		KeyAlgorithm:            "EC_prime256v1",
+		Tags:                    map[string]string{"team": "web", "env": "prod"},
+		CertificateTransparency: "disabled",
+		IdempotencyToken:        "token123",
+	}
+
+	req := input.toACMInput()
+
+	wantSANs := []string{"example.com", "*.example.com", "www.example.com"}
+	if len(req.SubjectAlternativeNames) != len(wantSANs) {
+		t.Fatalf("SANs = %v, want %v", req.SubjectAlternativeNames, wantSANs)
+	}
+	for i, san := range wantSANs {
+		if req.SubjectAlternativeNames[i] != san {
+			t.Errorf("SANs[%d] = %s, want %s", i, req.SubjectAlternativeNames[i], san)
+		}
+	}
+
+	if req.KeyAlgorithm != "EC_prime256v1" {
+		t.Errorf("KeyAlgorithm = %s", req.KeyAlgorithm)
+	}
+	if req.Options == nil || req.Options.CertificateTransparencyLoggingPreference != "DISABLED" {
+		t.Errorf("Options = %+v", req.Options)
+	}
+	if req.IdempotencyToken == nil || *req.IdempotencyToken != "token123" {
+		t.Errorf("IdempotencyToken = %v", req.IdempotencyToken)
+	}
+	if len(req.Tags) != 2 || *req.Tags[0].Key != "env" || *req.Tags[1].Key != "team" {
+		t.Errorf("Tags 应按 key 排序: %+v", req.Tags)
+	}
+}
+
+func TestDeriveIdempotencyToken(t *testing.T) {
+	a := CertificateRequest{Domain: "example.com", SANs: []string{"www.example.com", "*.example.com"}}.ToInput()
+	b := CertificateRequest{Domain: "example.com", SANs: []string{"*.example.com", "www.example.com", "example.com"}}.ToInput()
+	c := CertificateRequest{Domain: "example.com", SANs: []string{"www.example.com", "*.example.com"}, KeyAlgorithm: "EC_prime256v1"}.ToInput()
+	d := CertificateRequest{Domain: "example.com", IdempotencyToken: "custom"}.ToInput()
+
+	if a.IdempotencyToken != b.IdempotencyToken {
+		t.Errorf("相同 SAN 集合应生成相同令牌: %s != %s", a.IdempotencyToken, b.IdempotencyToken)
+	}
+	if a.IdempotencyToken == c.IdempotencyToken {
+		t.Error("不同密钥算法应生成不同令牌")
+	}
+	if d.IdempotencyToken != "custom" {
+		t.Errorf("应保留配置的令牌, got %s", d.IdempotencyToken)
+	}
+	if len(a.IdempotencyToken) != 32 {
+		t.Errorf("令牌长度 = %d, want 32", len(a.IdempotencyToken))
+	}
+	if err := a.Validate(); err != nil {
+		t.Errorf("生成的令牌应通过验证: %v", err)
+	}
+}
+
+func TestValidationRecordPollConfig(t *testing.T) {
+	defer config.SetConfigForTest(nil)
+
+	config.SetConfigForTest(nil)
+	if cfg := validationRecordPollConfig(); cfg.Timeout != defaultValidationRecordTimeout {
+		t.Errorf("未配置时 Timeout = %v, want %v", cfg.Timeout, defaultValidationRecordTimeout)
+	}
+
+	config.SetConfigForTest(&config.Config{API: config.APIConfig{Poll: config.PollConfig{Interval: 3, ValidationTimeout: 90}}})
+	cfg := validationRecordPollConfig()
+	if cfg.Interval != 3*time.Second || cfg.InitialDelay != 3*time.Second {
+		t.Errorf("Interval = %v, InitialDelay = %v, want 3s", cfg.Interval, cfg.InitialDelay)
+	}
+	if cfg.Timeout != 90*time.Second {
+		t.Errorf("Timeout = %v, want 90s", cfg.Timeout)
+	}
+}
@@ -0,0 +1,132 @@
+package aws
+
+import (
+	"context"
+	"sort"
+
+	"github.com/ado1t/cloudctl/internal/logger"
+)
+
+// CertificateUsage 证书及使用该证书的分发
+type CertificateUsage struct {
+	Certificate   Certificate
+	Distributions []Distribution
+}
+
+// DistributionCertificate 分发及其使用的证书
+type DistributionCertificate struct {
+	Distribution Distribution
+	// Certificate 分发使用的证书，使用默认证书或证书不在列表中时为 nil
+	Certificate *Certificate
+}
+
+// GetCertificateUsage 获取证书与 CloudFront 分发的对应关系
+// certificateARN 不为空时只返回该证书的使用情况
+func (c *Client) GetCertificateUsage(ctx context.Context, certificateARN string) ([]CertificateUsage, error) {
+	logger.Debug("获取证书使用情况", "arn", certificateARN)
+
+	certs, dists, err := c.listCertificatesAndDistributions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if certificateARN != "" {
+		certs = filterCertificatesByARN(certs, certificateARN)
+		// 列表中不存在时直接获取详情（如其他区域的证书）
+		if len(certs) == 0 {
+			cert, err := c.GetCertificate(ctx, certificateARN)
+			if err != nil {
+				return nil, err
+			}
+			certs = []Certificate{*cert}
+		}
+	}
+
+	usage := BuildCertificateUsage(certs, dists)
+	logger.Info("成功获取证书使用情况", "certificates", len(usage))
+	return usage, nil
+}
+
+// GetDistributionCertificates 获取每个 CloudFront 分发使用的证书
+func (c *Client) GetDistributionCertificates(ctx context.Context) ([]DistributionCertificate, error) {
+	logger.Debug("获取分发证书")
+
+	certs, dists, err := c.listCertificatesAndDistributions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := BuildDistributionCertificates(dists, certs)
+	logger.Info("成功获取分发证书", "distributions", len(result))
+	return result, nil
+}
+
+// listCertificatesAndDistributions 列出所有证书和分发
+func (c *Client) listCertificatesAndDistributions(ctx context.Context) ([]Certificate, []Distribution, error) {
+	certs, err := c.ListCertificates(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dists, err := c.ListDistributions(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return certs, dists, nil
+}
+
+// BuildCertificateUsage 根据分发的证书 ARN 建立证书到分发的对应关系
+// 结果按证书到期时间升序排列
+func BuildCertificateUsage(certs []Certificate, dists []Distribution) []CertificateUsage {
+	byARN := make(map[string][]Distribution)
+	for _, dist := range dists {
+		if dist.CertificateARN == "" {
+			continue
+		}
+		byARN[dist.CertificateARN] = append(byARN[dist.CertificateARN], dist)
+	}
+
+	usage := make([]CertificateUsage, 0, len(certs))
+	for _, cert := range certs {
+		usage = append(usage, CertificateUsage{
+			Certificate:   cert,
+			Distributions: byARN[cert.ARN],
+		})
+	}
+
+	sort.SliceStable(usage, func(i, j int) bool {
+		return usage[i].Certificate.NotAfter.Before(usage[j].Certificate.NotAfter)
+	})
+
+	return usage
+}
+
+// BuildDistributionCertificates 根据分发的证书 ARN 建立分发到证书的对应关系
+func BuildDistributionCertificates(dists []Distribution, certs []Certificate) []DistributionCertificate {
+	byARN := make(map[string]Certificate, len(certs))
+	for _, cert := range certs {
+		byARN[cert.ARN] = cert
+	}
+
+	result := make([]DistributionCertificate, 0, len(dists))
+	for _, dist := range dists {
+		item := DistributionCertificate{Distribution: dist}
+		if cert, ok := byARN[dist.CertificateARN]; ok {
+			item.Certificate = &cert
+		}
+		result = append(result, item)
+	}
+
+	return result
+}
+
+// filterCertificatesByARN 按 ARN 过滤证书
+func filterCertificatesByARN(certs []Certificate, arn string) []Certificate {
+	for _, cert := range certs {
+		if cert.ARN == arn {
+			return []Certificate{cert}
+		}
+	}
+	return nil
+}
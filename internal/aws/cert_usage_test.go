@@ -0,0 +1,68 @@
+package aws
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuildCertificateUsage(t *testing.T) {
+	now := time.Now()
+	certs := []Certificate{
+		{ARN: "arn:cert-a", DomainName: "a.com", NotAfter: now.Add(90 * 24 * time.Hour)},
+		{ARN: "arn:cert-b", DomainName: "b.com", NotAfter: now.Add(10 * 24 * time.Hour)},
+		{ARN: "arn:cert-unused", DomainName: "c.com", NotAfter: now.Add(30 * 24 * time.Hour)},
+	}
+	dists := []Distribution{
+		{ID: "E1", CertificateARN: "arn:cert-a", Aliases: []string{"www.a.com"}},
+		{ID: "E2", CertificateARN: "arn:cert-a", Aliases: []string{"cdn.a.com"}},
+		{ID: "E3", CertificateARN: "arn:cert-b"},
+		{ID: "E4"},
+	}
+
+	usage := BuildCertificateUsage(certs, dists)
+
+	if len(usage) != 3 {
+		t.Fatalf("len(usage) = %d, want 3", len(usage))
+	}
+
+	// 按到期时间排序
+	wantOrder := []string{"arn:cert-b", "arn:cert-unused", "arn:cert-a"}
+	for i, arn := range wantOrder {
+		if usage[i].Certificate.ARN != arn {
+			t.Errorf("usage[%d] = %s, want %s", i, usage[i].Certificate.ARN, arn)
+		}
+	}
+
+	if len(usage[2].Distributions) != 2 {
+		t.Errorf("cert-a 分发数 = %d, want 2", len(usage[2].Distributions))
+	}
+	if len(usage[1].Distributions) != 0 {
+		t.Errorf("未使用的证书不应有分发: %+v", usage[1].Distributions)
+	}
+}
+
+func TestBuildDistributionCertificates(t *testing.T) {
+	certs := []Certificate{
+		{ARN: "arn:cert-a", DomainName: "a.com", Status: "ISSUED"},
+	}
+	dists := []Distribution{
+		{ID: "E1", CertificateARN: "arn:cert-a"},
+		{ID: "E2", CertificateARN: "arn:unknown"},
+		{ID: "E3"},
+	}
+
+	result := BuildDistributionCertificates(dists, certs)
+
+	if len(result) != 3 {
+		t.Fatalf("len(result) = %d, want 3", len(result))
+	}
+	if result[0].Certificate == nil || result[0].Certificate.DomainName != "a.com" {
+		t.Errorf("E1 应关联 cert-a: %+v", result[0].Certificate)
+	}
+	if result[1].Certificate != nil {
+		t.Errorf("E2 的证书不在列表中，应为 nil")
+	}
+	if result[2].Certificate != nil {
+		t.Errorf("E3 使用默认证书，应为 nil")
+	}
+}
@@ -3,6 +3,7 @@ package aws
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/credentials"
@@ -13,17 +14,29 @@ import (
 	"github.com/ado1t/cloudctl/internal/logger"
 )
 
+// CloudFrontRegion CloudFront 所在区域，CloudFront 使用的 ACM 证书也必须位于该区域
+const CloudFrontRegion = "us-east-1"
+
 // Client AWS 客户端封装
 type Client struct {
 	cfg              aws.Config
 	cloudfrontClient *cloudfront.Client
 	acmClient        *acm.Client
 	profile          string
+	region           string
+	regions          []string
 }
 
 // NewClient 创建新的 AWS 客户端
+// ACM 客户端固定使用 us-east-1，适用于 CloudFront 相关操作
 func NewClient(profile string) (*Client, error) {
-	logger.Debug("创建 AWS 客户端", "profile", profile)
+	return NewClientWithRegion(profile, CloudFrontRegion)
+}
+
+// NewClientWithRegion 创建指定 ACM 区域的 AWS 客户端
+// region 为空时依次使用 profile 中配置的 region 和 us-east-1；CloudFront 客户端始终使用 us-east-1
+func NewClientWithRegion(profile, region string) (*Client, error) {
+	logger.Debug("创建 AWS 客户端", "profile", profile, "region", region)
 
 	// 获取配置
 	cfg := config.Get()
@@ -53,6 +66,14 @@ func NewClient(profile string) (*Client, error) {
 		return nil, fmt.Errorf("AWS profile '%s' 缺少 secret_access_key", profile)
 	}
 
+	// 确定 ACM 区域
+	if region == "" {
+		region = awsProfile.Region
+	}
+	if region == "" {
+		region = CloudFrontRegion
+	}
+
 	// 创建 AWS 配置
 	// CloudFront 是全局服务，不需要指定 region
 	awsConfig := aws.Config{
@@ -61,22 +82,26 @@ func NewClient(profile string) (*Client, error) {
 			awsProfile.SecretAccessKey,
 			"",
 		),
-		Region: "us-east-1", // CloudFront 使用 us-east-1
+		Region: CloudFrontRegion, // CloudFront 使用 us-east-1
 	}
 
 	// 创建 CloudFront 客户端
 	cfClient := cloudfront.NewFromConfig(awsConfig)
 
-	// 创建 ACM 客户端 (ACM 证书也必须在 us-east-1 区域)
-	acmClient := acm.NewFromConfig(awsConfig)
+	// 创建 ACM 客户端 (CloudFront 使用的证书必须在 us-east-1 区域，其他服务使用所在区域)
+	acmClient := acm.NewFromConfig(awsConfig, func(o *acm.Options) {
+		o.Region = region
+	})
 
-	logger.Info("AWS 客户端创建成功", "profile", profile)
+	logger.Info("AWS 客户端创建成功", "profile", profile, "region", region)
 
 	return &Client{
 		cfg:              awsConfig,
 		cloudfrontClient: cfClient,
 		acmClient:        acmClient,
 		profile:          profile,
+		region:           region,
+		regions:          awsProfile.Regions,
 	}, nil
 }
 
@@ -95,6 +120,35 @@ func (c *Client) Profile() string {
 	return c.profile
 }
 
+// Region 返回 ACM 客户端使用的区域
+func (c *Client) Region() string {
+	return c.region
+}
+
+// Regions 返回 profile 中配置的多区域列表
+func (c *Client) Regions() []string {
+	return c.regions
+}
+
+// acmClientForRegion 返回指定区域的 ACM 客户端
+func (c *Client) acmClientForRegion(region string) *acm.Client {
+	if region == "" || region == c.region {
+		return c.acmClient
+	}
+	return acm.NewFromConfig(c.cfg, func(o *acm.Options) {
+		o.Region = region
+	})
+}
+
+// RegionFromARN 从 ARN 中提取区域，格式: arn:partition:service:region:account:resource
+func RegionFromARN(arn string) string {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) < 6 || parts[0] != "arn" {
+		return ""
+	}
+	return parts[3]
+}
+
 // Config 返回 AWS 配置
 func (c *Client) Config() aws.Config {
 	return c.cfg
@@ -144,6 +144,82 @@ func TestNewClient_MissingCredentials(t *testing.T) {
 	}
 }
 
+func TestNewClientWithRegion(t *testing.T) {
+	cfg := &config.Config{
+		DefaultProfile: config.DefaultProfile{
+			AWS: "hk",
+		},
+		AWS: map[string]config.AWSProfile{
+			"hk": {
+				AccessKeyID:     "key",
+				SecretAccessKey: "secret",
+				Region:          "ap-east-1",
+				Regions:         []string{"us-east-1", "ap-east-1"},
+			},
+			"no-region": {
+				AccessKeyID:     "key",
+				SecretAccessKey: "secret",
+			},
+		},
+	}
+	config.SetConfigForTest(cfg)
+
+	tests := []struct {
+		name    string
+		profile string
+		region  string
+		want    string
+	}{
+		{"指定区域优先", "hk", "eu-west-1", "eu-west-1"},
+		{"使用 profile 区域", "hk", "", "ap-east-1"},
+		{"默认 us-east-1", "no-region", "", "us-east-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewClientWithRegion(tt.profile, tt.region)
+			if err != nil {
+				t.Fatalf("NewClientWithRegion() 错误 = %v", err)
+			}
+			if client.Region() != tt.want {
+				t.Errorf("Region() = %v, 期望 %v", client.Region(), tt.want)
+			}
+		})
+	}
+
+	// NewClient 始终使用 CloudFront 所在区域
+	client, err := NewClient("hk")
+	if err != nil {
+		t.Fatalf("NewClient() 错误 = %v", err)
+	}
+	if client.Region() != CloudFrontRegion {
+		t.Errorf("NewClient().Region() = %v, 期望 %v", client.Region(), CloudFrontRegion)
+	}
+	if len(client.Regions()) != 2 {
+		t.Errorf("Regions() = %v, 期望 2 个区域", client.Regions())
+	}
+}
+
+func TestRegionFromARN(t *testing.T) {
+	tests := []struct {
+		arn  string
+		want string
+	}{
+		{"arn:aws:acm:ap-east-1:123456789012:certificate/abc", "ap-east-1"},
+		{"arn:aws:acm:us-east-1:123456789012:certificate/abc", "us-east-1"},
+		{"not-an-arn", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.arn, func(t *testing.T) {
+			if got := RegionFromARN(tt.arn); got != tt.want {
+				t.Errorf("RegionFromARN(%q) = %q, 期望 %q", tt.arn, got, tt.want)
+			}
+		})
+	}
+}
+
 // contains 检查字符串是否包含子串
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || len(substr) == 0 ||
@@ -0,0 +1,160 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ado1t/cloudctl/internal/config"
+	"github.com/ado1t/cloudctl/internal/logger"
+)
+
+// ErrPollTimeout 轮询超时
+var ErrPollTimeout = errors.New("等待超时")
+
+// PollConfig 轮询配置
+type PollConfig struct {
+	// InitialDelay 首次检查前的等待时间
+	InitialDelay time.Duration
+	// Interval 初始轮询间隔
+	Interval time.Duration
+	// MaxInterval 最大轮询间隔
+	MaxInterval time.Duration
+	// Backoff 每次轮询后间隔的放大倍数，小于等于 1 时使用固定间隔
+	Backoff float64
+	// Timeout 总超时时间，为 0 时只受 ctx 控制
+	Timeout time.Duration
+	// OnProgress 每次检查后的进度回调
+	OnProgress func(PollProgress)
+}
+
+// PollProgress 轮询进度
+type PollProgress struct {
+	Attempt int
+	Elapsed time.Duration
+	Status  string
+	Done    bool
+}
+
+// PollFunc 轮询检查函数
+// 返回 done=true 表示条件已满足；返回 err 表示不可恢复的错误，立即停止轮询
+type PollFunc func(ctx context.Context) (done bool, status string, err error)
+
+// DefaultPollConfig 根据全局配置生成轮询配置
+func DefaultPollConfig() PollConfig {
+	cfg := PollConfig{
+		Interval:    5 * time.Second,
+		MaxInterval: 30 * time.Second,
+		Backoff:     1.5,
+		Timeout:     30 * time.Minute,
+	}
+
+	if c := config.Get(); c != nil {
+		poll := c.API.Poll
+		if poll.Interval > 0 {
+			cfg.Interval = time.Duration(poll.Interval) * time.Second
+		}
+		if poll.MaxInterval > 0 {
+			cfg.MaxInterval = time.Duration(poll.MaxInterval) * time.Second
+		}
+		if poll.Backoff > 0 {
+			cfg.Backoff = poll.Backoff
+		}
+		if poll.Timeout > 0 {
+			cfg.Timeout = time.Duration(poll.Timeout) * time.Second
+		}
+	}
+
+	return cfg
+}
+
+// Poll 按配置轮询检查函数直到条件满足、出错、超时或 ctx 被取消
+func Poll(ctx context.Context, cfg PollConfig, check PollFunc) error {
+	if cfg.Interval <= 0 {
+		cfg.Interval = time.Second
+	}
+	if cfg.MaxInterval < cfg.Interval {
+		cfg.MaxInterval = cfg.Interval
+	}
+
+	if cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.Timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	interval := cfg.Interval
+	status := ""
+
+	if cfg.InitialDelay > 0 {
+		if err := sleepContext(ctx, cfg.InitialDelay); err != nil {
+			return pollError(err, status)
+		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		done, s, err := check(ctx)
+		if s != "" {
+			status = s
+		}
+
+		if cfg.OnProgress != nil {
+			cfg.OnProgress(PollProgress{
+				Attempt: attempt,
+				Elapsed: time.Since(start),
+				Status:  status,
+				Done:    done && err == nil,
+			})
+		}
+
+		if err != nil {
+			// 检查函数因 ctx 超时返回的错误按超时处理
+			if ctx.Err() != nil {
+				return pollError(ctx.Err(), status)
+			}
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		logger.Debug("等待下一次检查", "attempt", attempt, "status", status, "interval", interval)
+		if err := sleepContext(ctx, interval); err != nil {
+			return pollError(err, status)
+		}
+
+		// 计算下一次间隔
+		if cfg.Backoff > 1 {
+			interval = time.Duration(float64(interval) * cfg.Backoff)
+			if interval > cfg.MaxInterval {
+				interval = cfg.MaxInterval
+			}
+		}
+	}
+}
+
+// sleepContext 等待指定时间，ctx 取消时提前返回
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// pollError 将 ctx 错误转换为轮询错误，超时返回 ErrPollTimeout
+func pollError(err error, status string) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		if status != "" {
+			return fmt.Errorf("%w (最后状态: %s)", ErrPollTimeout, status)
+		}
+		return ErrPollTimeout
+	}
+	return err
+}
@@ -0,0 +1,114 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPoll_Success(t *testing.T) {
+	var progress []PollProgress
+	cfg := PollConfig{
+		Interval:    time.Millisecond,
+		MaxInterval: 5 * time.Millisecond,
+		Backoff:     2,
+		Timeout:     time.Second,
+		OnProgress: func(p PollProgress) {
+			progress = append(progress, p)
+		},
+	}
+
+	calls := 0
+	err := Poll(context.Background(), cfg, func(ctx context.Context) (bool, string, error) {
+		calls++
+		if calls < 3 {
+			return false, "InProgress", nil
+		}
+		return true, "Deployed", nil
+	})
+
+	if err != nil {
+		t.Fatalf("Poll() error = %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("calls = %d, want 3", calls)
+	}
+	if len(progress) != 3 {
+		t.Fatalf("progress 回调次数 = %d, want 3", len(progress))
+	}
+	last := progress[len(progress)-1]
+	if !last.Done || last.Status != "Deployed" || last.Attempt != 3 {
+		t.Errorf("最后一次进度 = %+v", last)
+	}
+}
+
+func TestPoll_Timeout(t *testing.T) {
+	cfg := PollConfig{
+		Interval: 5 * time.Millisecond,
+		Timeout:  20 * time.Millisecond,
+	}
+
+	err := Poll(context.Background(), cfg, func(ctx context.Context) (bool, string, error) {
+		return false, "InProgress", nil
+	})
+
+	if !errors.Is(err, ErrPollTimeout) {
+		t.Fatalf("Poll() error = %v, want ErrPollTimeout", err)
+	}
+	if !contains(err.Error(), "InProgress") {
+		t.Errorf("超时错误应包含最后状态: %v", err)
+	}
+}
+
+func TestPoll_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cfg := PollConfig{
+		Interval: time.Hour,
+	}
+
+	calls := 0
+	done := make(chan error, 1)
+	go func() {
+		done <- Poll(ctx, cfg, func(ctx context.Context) (bool, string, error) {
+			calls++
+			return false, "", nil
+		})
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Poll() error = %v, want context.Canceled", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("ctx 取消后 Poll 应立即返回")
+	}
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1", calls)
+	}
+}
+
+func TestPoll_CheckError(t *testing.T) {
+	wantErr := errors.New("证书状态为 FAILED")
+	cfg := PollConfig{
+		Interval: time.Millisecond,
+		Timeout:  time.Second,
+	}
+
+	calls := 0
+	err := Poll(context.Background(), cfg, func(ctx context.Context) (bool, string, error) {
+		calls++
+		return false, "FAILED", wantErr
+	})
+
+	if !errors.Is(err, wantErr) {
+		t.Errorf("Poll() error = %v, want %v", err, wantErr)
+	}
+	if calls != 1 {
+		t.Errorf("出错后应立即停止, calls = %d", calls)
+	}
+}
@@ -2,6 +2,7 @@ package cloudflare
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -18,24 +19,41 @@ type DNSRecordInfo struct {
 	TTL        float64   `json:"ttl"`
 	Proxied    bool      `json:"proxied"`
 	Proxiable  bool      `json:"proxiable"`
+	Comment    string    `json:"comment,omitempty"`
+	Tags       []string  `json:"tags,omitempty"`
 	CreatedOn  time.Time `json:"created_on"`
 	ModifiedOn time.Time `json:"modified_on"`
 }
 
 // DNSRecordCreateParams DNS 记录创建参数
 type DNSRecordCreateParams struct {
-	Type    string  // 记录类型: A, CNAME 等
-	Name    string  // 记录名称
-	Content string  // 记录内容
-	TTL     float64 // TTL (1 = auto)
-	Proxied bool    // 是否启用 Cloudflare 代理
+	Type    string   // 记录类型: A, CNAME 等
+	Name    string   // 记录名称
+	Content string   // 记录内容
+	TTL     float64  // TTL (1 = auto)
+	Proxied bool     // 是否启用 Cloudflare 代理
+	Comment string   // 备注
+	Tags    []string // 标签，格式: name:value
 }
 
 // DNSRecordUpdateParams DNS 记录更新参数
 type DNSRecordUpdateParams struct {
-	Content *string  // 记录内容
-	TTL     *float64 // TTL
-	Proxied *bool    // 是否启用 Cloudflare 代理
+	Content *string   // 记录内容
+	TTL     *float64  // TTL
+	Proxied *bool     // 是否启用 Cloudflare 代理
+	Comment *string   // 备注
+	Tags    *[]string // 标签，nil 表示不修改
+}
+
+// ProxiedRecords 返回启用了 Cloudflare 代理的记录
+func ProxiedRecords(records []DNSRecordInfo) []DNSRecordInfo {
+	var proxied []DNSRecordInfo
+	for _, record := range records {
+		if record.Proxied {
+			proxied = append(proxied, record)
+		}
+	}
+	return proxied
 }
 
 // ListDNSRecords 列出指定 Zone 的所有 DNS 记录
@@ -76,25 +94,7 @@ func (c *Client) ListDNSRecords(ctx context.Context, zoneID string, recordType s
 
 			// 转换为我们的数据结构
 			for _, record := range result.Result {
-				// Content 是 interface{} 类型，需要转换为字符串
-				contentStr := ""
-				if record.Content != nil {
-					contentStr = fmt.Sprintf("%v", record.Content)
-				}
-
-				recordInfo := DNSRecordInfo{
-					ID:         record.ID,
-					Type:       string(record.Type),
-					Name:       record.Name,
-					Content:    contentStr,
-					TTL:        float64(record.TTL),
-					Proxied:    record.Proxied,
-					Proxiable:  record.Proxiable,
-					CreatedOn:  record.CreatedOn,
-					ModifiedOn: record.ModifiedOn,
-				}
-
-				allRecords = append(allRecords, recordInfo)
+				allRecords = append(allRecords, convertRecord(&record))
 			}
 
 			c.logger.Debug("获取到 DNS 记录",
@@ -135,23 +135,8 @@ func (c *Client) GetDNSRecord(ctx context.Context, zoneID, recordID string) (*DN
 			return fmt.Errorf("获取 DNS 记录失败: %w", err)
 		}
 
-		// Content 是 interface{} 类型，需要转换为字符串
-		contentStr := ""
-		if result.Content != nil {
-			contentStr = fmt.Sprintf("%v", result.Content)
-		}
-
-		recordInfo = &DNSRecordInfo{
-			ID:         result.ID,
-			Type:       string(result.Type),
-			Name:       result.Name,
-			Content:    contentStr,
-			TTL:        float64(result.TTL),
-			Proxied:    result.Proxied,
-			Proxiable:  result.Proxiable,
-			CreatedOn:  result.CreatedOn,
-			ModifiedOn: result.ModifiedOn,
-		}
+		info := convertRecord(result)
+		recordInfo = &info
 
 		return nil
 	})
@@ -175,6 +160,11 @@ func (c *Client) CreateDNSRecord(ctx context.Context, zoneID string, params DNSR
 		"content", params.Content,
 	)
 
+	// nil 会被编码为 null，没有标签时发送空列表
+	if params.Tags == nil {
+		params.Tags = []string{}
+	}
+
 	err := c.WithRetry(ctx, "创建 DNS 记录", func() error {
 		// 构建创建参数 - 根据记录类型创建不同的参数
 		var recordParam dns.RecordUnionParam
@@ -187,6 +177,8 @@ func (c *Client) CreateDNSRecord(ctx context.Context, zoneID string, params DNSR
 				Content: cloudflare.F(params.Content),
 				TTL:     cloudflare.F(dns.TTL(params.TTL)),
 				Proxied: cloudflare.F(params.Proxied),
+				Comment: cloudflare.F(params.Comment),
+				Tags:    cloudflare.F(params.Tags),
 			}
 		case "AAAA":
 			recordParam = dns.AAAARecordParam{
@@ -195,6 +187,8 @@ func (c *Client) CreateDNSRecord(ctx context.Context, zoneID string, params DNSR
 				Content: cloudflare.F(params.Content),
 				TTL:     cloudflare.F(dns.TTL(params.TTL)),
 				Proxied: cloudflare.F(params.Proxied),
+				Comment: cloudflare.F(params.Comment),
+				Tags:    cloudflare.F(params.Tags),
 			}
 		case "CNAME":
 			recordParam = dns.CNAMERecordParam{
@@ -203,6 +197,8 @@ func (c *Client) CreateDNSRecord(ctx context.Context, zoneID string, params DNSR
 				Content: cloudflare.F[interface{}](params.Content),
 				TTL:     cloudflare.F(dns.TTL(params.TTL)),
 				Proxied: cloudflare.F(params.Proxied),
+				Comment: cloudflare.F(params.Comment),
+				Tags:    cloudflare.F(params.Tags),
 			}
 		default:
 			return fmt.Errorf("不支持的记录类型: %s", params.Type)
@@ -217,23 +213,8 @@ func (c *Client) CreateDNSRecord(ctx context.Context, zoneID string, params DNSR
 			return fmt.Errorf("创建 DNS 记录失败: %w", err)
 		}
 
-		// Content 是 interface{} 类型，需要转换为字符串
-		contentStr := ""
-		if result.Content != nil {
-			contentStr = fmt.Sprintf("%v", result.Content)
-		}
-
-		recordInfo = &DNSRecordInfo{
-			ID:         result.ID,
-			Type:       string(result.Type),
-			Name:       result.Name,
-			Content:    contentStr,
-			TTL:        float64(result.TTL),
-			Proxied:    result.Proxied,
-			Proxiable:  result.Proxiable,
-			CreatedOn:  result.CreatedOn,
-			ModifiedOn: result.ModifiedOn,
-		}
+		info := convertRecord(result)
+		recordInfo = &info
 
 		return nil
 	})
@@ -267,6 +248,8 @@ func (c *Client) UpdateDNSRecord(ctx context.Context, zoneID, recordID string, r
 	content := existingRecord.Content
 	ttl := existingRecord.TTL
 	proxied := existingRecord.Proxied
+	comment := existingRecord.Comment
+	tags := existingRecord.Tags
 
 	// 应用更新的值
 	if params.Content != nil {
@@ -278,6 +261,16 @@ func (c *Client) UpdateDNSRecord(ctx context.Context, zoneID, recordID string, r
 	if params.Proxied != nil {
 		proxied = *params.Proxied
 	}
+	if params.Comment != nil {
+		comment = *params.Comment
+	}
+	if params.Tags != nil {
+		tags = *params.Tags
+	}
+	// nil 会被编码为 null，没有标签时发送空列表
+	if tags == nil {
+		tags = []string{}
+	}
 
 	err = c.WithRetry(ctx, "更新 DNS 记录", func() error {
 		// 构建更新参数 - 根据记录类型创建不同的参数
@@ -291,6 +284,8 @@ func (c *Client) UpdateDNSRecord(ctx context.Context, zoneID, recordID string, r
 				Content: cloudflare.F(content),
 				TTL:     cloudflare.F(dns.TTL(ttl)),
 				Proxied: cloudflare.F(proxied),
+				Comment: cloudflare.F(comment),
+				Tags:    cloudflare.F(tags),
 			}
 		case "AAAA":
 			recordParam = dns.AAAARecordParam{
@@ -299,6 +294,8 @@ func (c *Client) UpdateDNSRecord(ctx context.Context, zoneID, recordID string, r
 				Content: cloudflare.F(content),
 				TTL:     cloudflare.F(dns.TTL(ttl)),
 				Proxied: cloudflare.F(proxied),
+				Comment: cloudflare.F(comment),
+				Tags:    cloudflare.F(tags),
 			}
 		case "CNAME":
 			recordParam = dns.CNAMERecordParam{
@@ -307,6 +304,8 @@ func (c *Client) UpdateDNSRecord(ctx context.Context, zoneID, recordID string, r
 				Content: cloudflare.F[interface{}](content),
 				TTL:     cloudflare.F(dns.TTL(ttl)),
 				Proxied: cloudflare.F(proxied),
+				Comment: cloudflare.F(comment),
+				Tags:    cloudflare.F(tags),
 			}
 		default:
 			return fmt.Errorf("不支持的记录类型: %s", recordType)
@@ -321,23 +320,8 @@ func (c *Client) UpdateDNSRecord(ctx context.Context, zoneID, recordID string, r
 			return fmt.Errorf("更新 DNS 记录失败: %w", err)
 		}
 
-		// Content 是 interface{} 类型，需要转换为字符串
-		contentStr := ""
-		if result.Content != nil {
-			contentStr = fmt.Sprintf("%v", result.Content)
-		}
-
-		recordInfo = &DNSRecordInfo{
-			ID:         result.ID,
-			Type:       string(result.Type),
-			Name:       result.Name,
-			Content:    contentStr,
-			TTL:        float64(result.TTL),
-			Proxied:    result.Proxied,
-			Proxiable:  result.Proxiable,
-			CreatedOn:  result.CreatedOn,
-			ModifiedOn: result.ModifiedOn,
-		}
+		info := convertRecord(result)
+		recordInfo = &info
 
 		return nil
 	})
@@ -372,3 +356,35 @@ func (c *Client) DeleteDNSRecord(ctx context.Context, zoneID, recordID string) e
 	c.logger.Info("成功删除 DNS 记录", "record_id", recordID)
 	return nil
 }
+
+// convertRecord 将 API 返回的记录转换为 DNSRecordInfo
+func convertRecord(record *dns.Record) DNSRecordInfo {
+	// Content 是 interface{} 类型，需要转换为字符串
+	contentStr := ""
+	if record.Content != nil {
+		contentStr = fmt.Sprintf("%v", record.Content)
+	}
+
+	return DNSRecordInfo{
+		ID:         record.ID,
+		Type:       string(record.Type),
+		Name:       record.Name,
+		Content:    contentStr,
+		TTL:        float64(record.TTL),
+		Proxied:    record.Proxied,
+		Proxiable:  record.Proxiable,
+		Comment:    record.Comment,
+		Tags:       recordTags(record.JSON.Tags.Raw()),
+		CreatedOn:  record.CreatedOn,
+		ModifiedOn: record.ModifiedOn,
+	}
+}
+
+// recordTags 解析标签的原始 JSON，标签为空时返回 nil
+func recordTags(raw string) []string {
+	var tags []string
+	if err := json.Unmarshal([]byte(raw), &tags); err != nil || len(tags) == 0 {
+		return nil
+	}
+	return tags
+}
@@ -4,15 +4,91 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
 	"gopkg.in/yaml.v3"
 )
 
+// DNSBatchOperation 批量 DNS 操作类型
+type DNSBatchOperation string
+
+const (
+	// DNSBatchCreate 批量创建记录
+	DNSBatchCreate DNSBatchOperation = "create"
+	// DNSBatchUpdate 批量更新记录，按 name/type 匹配现有记录
+	DNSBatchUpdate DNSBatchOperation = "update"
+	// DNSBatchDelete 批量删除记录，按 name/type 匹配现有记录
+	DNSBatchDelete DNSBatchOperation = "delete"
+)
+
+// Label 返回操作的中文名称
+func (op DNSBatchOperation) Label() string {
+	switch op {
+	case DNSBatchUpdate:
+		return "更新"
+	case DNSBatchDelete:
+		return "删除"
+	default:
+		return "创建"
+	}
+}
+
+// progressVerb 返回进度信息中使用的动词
+func (op DNSBatchOperation) progressVerb() string {
+	switch op {
+	case DNSBatchUpdate:
+		return "Updating"
+	case DNSBatchDelete:
+		return "Deleting"
+	default:
+		return "Creating"
+	}
+}
+
+// ConflictPolicy 批量创建时记录已存在的处理策略
+type ConflictPolicy string
+
+const (
+	// ConflictFail 记录已存在时视为失败（默认）
+	ConflictFail ConflictPolicy = "fail"
+	// ConflictSkip 已存在完全相同的记录时视为成功并跳过，内容不同时仍视为失败
+	ConflictSkip ConflictPolicy = "skip"
+	// ConflictUpdate 已存在的记录与配置不同时更新 content、ttl 和 proxied
+	ConflictUpdate ConflictPolicy = "update"
+)
+
+// ParseConflictPolicy 解析冲突处理策略，空字符串返回默认策略
+func ParseConflictPolicy(s string) (ConflictPolicy, error) {
+	switch policy := ConflictPolicy(strings.ToLower(strings.TrimSpace(s))); policy {
+	case "":
+		return ConflictFail, nil
+	case ConflictFail, ConflictSkip, ConflictUpdate:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("无效的冲突处理策略: %s (支持: fail, skip, update)", s)
+	}
+}
+
+// 记录的处理结果
+const (
+	RecordActionCreated = "created"
+	RecordActionUpdated = "updated"
+	RecordActionSkipped = "skipped"
+	RecordActionDeleted = "deleted"
+	RecordActionFailed  = "failed"
+)
+
 // DNSBatchConfig 批量 DNS 操作配置
 type DNSBatchConfig struct {
-	Zones []DNSZoneConfig `yaml:"zones"`
+	// OnConflict 批量创建时记录已存在的处理策略 (fail|skip|update)
+	OnConflict ConflictPolicy `yaml:"on_conflict,omitempty"`
+	// OwnerTag 所有权标签，例如 managed-by:cloudctl；配置后创建和更新的记录会自动添加该标签，
+	// 更新和删除只操作带有该标签的记录，为空时不添加标签也不检查所有权
+	OwnerTag string          `yaml:"owner_tag,omitempty"`
+	Zones    []DNSZoneConfig `yaml:"zones"`
 }
 
 // DNSZoneConfig Zone 配置
@@ -27,7 +103,19 @@ type DNSRecordConfig struct {
 	Name    string  `yaml:"name"`
 	Content string  `yaml:"content"`
 	TTL     float64 `yaml:"ttl,omitempty"`
-	Proxied bool    `yaml:"proxied,omitempty"`
+	// Proxied 是否启用代理，创建时未配置为不启用，更新时未配置保留现有值
+	Proxied *bool `yaml:"proxied,omitempty"`
+	// MatchContent 更新和删除时只匹配内容相同的记录，用于区分同名同类型的多条记录
+	MatchContent string `yaml:"match_content,omitempty"`
+	// Comment 记录备注，为空时更新不修改现有备注
+	Comment string `yaml:"comment,omitempty"`
+	// Tags 记录标签 (name:value)，更新时合并到现有标签
+	Tags []string `yaml:"tags,omitempty"`
+}
+
+// IsProxied 返回配置是否启用代理，未配置时为 false
+func (r DNSRecordConfig) IsProxied() bool {
+	return r.Proxied != nil && *r.Proxied
 }
 
 // DNSBatchResult 批量操作结果
@@ -57,16 +145,23 @@ type DNSZoneResult struct {
 
 // DNSRecordResult 记录操作结果
 type DNSRecordResult struct {
-	Type     string
-	Name     string
-	Content  string
-	Success  bool
+	Type    string
+	Name    string
+	Content string
+	Success bool
+	// Action 实际执行的操作 (created|updated|skipped|deleted|failed)
+	Action   string
 	Error    error
 	RecordID string
 }
 
-// LoadDNSBatchConfig 从 YAML 文件加载批量配置
+// LoadDNSBatchConfig 从 YAML 文件加载批量创建配置
 func LoadDNSBatchConfig(filename string) (*DNSBatchConfig, error) {
+	return LoadDNSBatchConfigFor(filename, DNSBatchCreate)
+}
+
+// LoadDNSBatchConfigFor 从 YAML 文件加载批量配置，并按操作类型验证
+func LoadDNSBatchConfigFor(filename string, op DNSBatchOperation) (*DNSBatchConfig, error) {
 	data, err := os.ReadFile(filename)
 	if err != nil {
 		return nil, fmt.Errorf("读取配置文件失败: %w", err)
@@ -78,19 +173,34 @@ func LoadDNSBatchConfig(filename string) (*DNSBatchConfig, error) {
 	}
 
 	// 验证配置
-	if err := config.Validate(); err != nil {
+	if err := config.ValidateFor(op); err != nil {
 		return nil, fmt.Errorf("配置验证失败: %w", err)
 	}
 
 	return &config, nil
 }
 
-// Validate 验证配置
+// Validate 验证批量创建配置
 func (c *DNSBatchConfig) Validate() error {
+	return c.ValidateFor(DNSBatchCreate)
+}
+
+// ValidateFor 按操作类型验证配置，删除操作不要求 content
+func (c *DNSBatchConfig) ValidateFor(op DNSBatchOperation) error {
 	if len(c.Zones) == 0 {
 		return fmt.Errorf("至少需要配置一个 zone")
 	}
 
+	if _, err := ParseConflictPolicy(string(c.OnConflict)); err != nil {
+		return err
+	}
+
+	if c.OwnerTag != "" {
+		if err := ValidateTag(c.OwnerTag); err != nil {
+			return fmt.Errorf("owner_tag: %w", err)
+		}
+	}
+
 	for i, zone := range c.Zones {
 		if zone.Zone == "" {
 			return fmt.Errorf("zone[%d]: zone 名称不能为空", i)
@@ -109,7 +219,7 @@ func (c *DNSBatchConfig) Validate() error {
 				return fmt.Errorf("zone[%d] (%s) record[%d]: name 不能为空", i, zone.Zone, j)
 			}
 
-			if record.Content == "" {
+			if record.Content == "" && op != DNSBatchDelete {
 				return fmt.Errorf("zone[%d] (%s) record[%d]: content 不能为空", i, zone.Zone, j)
 			}
 
@@ -120,6 +230,12 @@ func (c *DNSBatchConfig) Validate() error {
 					i, zone.Zone, j, record.Type)
 			}
 
+			for _, tag := range record.Tags {
+				if err := ValidateTag(tag); err != nil {
+					return fmt.Errorf("zone[%d] (%s) record[%d]: %w", i, zone.Zone, j, err)
+				}
+			}
+
 			// 设置默认 TTL
 			if record.TTL == 0 {
 				record.TTL = 1 // auto
@@ -156,7 +272,7 @@ func (c *Client) BatchCreateDNSRecords(ctx context.Context, config *DNSBatchConf
 				zoneIdx+1, result.TotalZones, zoneConfig.Zone))
 		}
 
-		zoneResult := c.processZone(ctx, zoneConfig, progressCallback)
+		zoneResult := c.processZone(ctx, DNSBatchCreate, config.OnConflict, config.OwnerTag, zoneConfig, progressCallback)
 		result.ZoneResults = append(result.ZoneResults, zoneResult)
 
 		if zoneResult.Success {
@@ -183,15 +299,15 @@ func (c *Client) BatchCreateDNSRecords(ctx context.Context, config *DNSBatchConf
 	return result, nil
 }
 
-// processZone 处理单个 zone
-func (c *Client) processZone(ctx context.Context, zoneConfig DNSZoneConfig, progressCallback func(string)) DNSZoneResult {
+// processZone 按操作类型处理单个 zone，policy 只用于创建操作，ownerTag 为空时不检查所有权
+func (c *Client) processZone(ctx context.Context, op DNSBatchOperation, policy ConflictPolicy, ownerTag string, zoneConfig DNSZoneConfig, progressCallback func(string)) DNSZoneResult {
 	result := DNSZoneResult{
 		Zone:          zoneConfig.Zone,
 		TotalRecords:  len(zoneConfig.Records),
 		RecordResults: make([]DNSRecordResult, 0, len(zoneConfig.Records)),
 	}
 
-	c.logger.Info("处理 zone", "zone", zoneConfig.Zone, "records", result.TotalRecords)
+	c.logger.Info("处理 zone", "zone", zoneConfig.Zone, "operation", op, "records", result.TotalRecords)
 
 	// 获取 Zone ID
 	zone, err := c.GetZoneByName(ctx, zoneConfig.Zone)
@@ -203,15 +319,40 @@ func (c *Client) processZone(ctx context.Context, zoneConfig DNSZoneConfig, prog
 		return result
 	}
 
+	// 更新、删除以及按 policy 处理已存在记录的创建需要先获取现有记录用于匹配
+	var existing []DNSRecordInfo
+	var matcher *existingRecordMatcher
+	if op != DNSBatchCreate || handlesConflicts(policy) {
+		existing, err = c.ListDNSRecords(ctx, zone.ID, "")
+		if err != nil {
+			c.logger.Error("获取 DNS 记录失败", "zone", zoneConfig.Zone, "error", err)
+			result.Success = false
+			result.Error = err
+			result.FailedRecords = result.TotalRecords
+			return result
+		}
+	}
+	if op == DNSBatchCreate && handlesConflicts(policy) {
+		matcher = newExistingRecordMatcher(zone, existing, zoneConfig.Records)
+	}
+
 	// 处理每条记录
 	for recordIdx, recordConfig := range zoneConfig.Records {
 		if progressCallback != nil {
-			progressCallback(fmt.Sprintf("  └─ %s: Creating record %d/%d (%s %s)",
-				zoneConfig.Zone, recordIdx+1, result.TotalRecords,
+			progressCallback(fmt.Sprintf("  └─ %s: %s record %d/%d (%s %s)",
+				zoneConfig.Zone, op.progressVerb(), recordIdx+1, result.TotalRecords,
 				recordConfig.Type, recordConfig.Name))
 		}
 
-		recordResult := c.processRecord(ctx, zone.ID, recordConfig)
+		var recordResult DNSRecordResult
+		switch op {
+		case DNSBatchUpdate:
+			recordResult = c.processUpdateRecord(ctx, zone, existing, recordConfig, ownerTag)
+		case DNSBatchDelete:
+			recordResult = c.processDeleteRecord(ctx, zone, existing, recordConfig, ownerTag)
+		default:
+			recordResult = c.processCreateRecord(ctx, zone, matcher, recordIdx, recordConfig, policy, ownerTag)
+		}
 		result.RecordResults = append(result.RecordResults, recordResult)
 
 		if recordResult.Success {
@@ -226,23 +367,131 @@ func (c *Client) processZone(ctx context.Context, zoneConfig DNSZoneConfig, prog
 	return result
 }
 
-// processRecord 处理单条记录
-func (c *Client) processRecord(ctx context.Context, zoneID string, recordConfig DNSRecordConfig) DNSRecordResult {
+// handlesConflicts 检查 policy 是否需要处理已存在的记录（skip 或 update）
+func handlesConflicts(policy ConflictPolicy) bool {
+	return policy != "" && policy != ConflictFail
+}
+
+// processCreateRecord 创建配置中第 idx 条记录，matcher 不为 nil 时先匹配同名同类型的现有记录，
+// 找到时按 policy 跳过或更新该记录而不是创建新记录
+func (c *Client) processCreateRecord(ctx context.Context, zone *ZoneInfo, matcher *existingRecordMatcher, idx int, recordConfig DNSRecordConfig, policy ConflictPolicy, ownerTag string) DNSRecordResult {
+	if matcher == nil {
+		return c.processRecord(ctx, zone, recordConfig, policy, ownerTag)
+	}
+
+	record, err := matcher.match(idx)
+	if err != nil {
+		return DNSRecordResult{
+			Type:    recordConfig.Type,
+			Name:    recordConfig.Name,
+			Content: recordConfig.Content,
+			Action:  RecordActionFailed,
+			Error:   err,
+		}
+	}
+	if record != nil {
+		return c.applyConflict(ctx, zone, *record, recordConfig, policy, ownerTag)
+	}
+	return c.processRecord(ctx, zone, recordConfig, policy, ownerTag)
+}
+
+// existingRecordMatcher 创建前将配置中的记录与同名同类型的现有记录配对。
+// Cloudflare 允许多条同名的 A/AAAA 记录，直接创建不会返回冲突，需要在创建前匹配。
+// 每条现有记录只与一条配置配对；与其他配置内容相同的现有记录留给该配置，
+// 避免轮询 (round-robin) 配置中的记录互相覆盖
+type existingRecordMatcher struct {
+	zone     *ZoneInfo
+	existing []DNSRecordInfo
+	configs  []DNSRecordConfig
+	claimed  map[string]bool
+}
+
+// newExistingRecordMatcher 创建记录匹配器
+func newExistingRecordMatcher(zone *ZoneInfo, existing []DNSRecordInfo, configs []DNSRecordConfig) *existingRecordMatcher {
+	return &existingRecordMatcher{zone: zone, existing: existing, configs: configs, claimed: make(map[string]bool)}
+}
+
+// match 返回与第 idx 条配置配对的现有记录，没有同名同类型的记录时返回 nil；
+// 有多条内容都不同的候选记录时无法确定要处理的记录，返回冲突错误
+func (m *existingRecordMatcher) match(idx int) (*DNSRecordInfo, error) {
+	recordConfig := m.configs[idx]
+	name := NormalizeRecordName(recordConfig.Name, m.zone.Name)
+	recordType := strings.ToUpper(recordConfig.Type)
+
+	var candidates []DNSRecordInfo
+	for _, record := range MatchDNSRecords(m.existing, DNSRecordSelector{Name: name, Type: recordType}) {
+		if m.claimed[record.ID] || m.reserved(idx, record) {
+			continue
+		}
+		candidates = append(candidates, record)
+	}
+
+	var matched *DNSRecordInfo
+	for i := range candidates {
+		if strings.EqualFold(candidates[i].Content, recordConfig.Content) {
+			matched = &candidates[i]
+			break
+		}
+	}
+	if matched == nil && len(candidates) == 1 {
+		matched = &candidates[0]
+	}
+	if matched == nil && len(candidates) > 1 {
+		return nil, NewConflictError("创建 DNS 记录", fmt.Sprintf(
+			"%s %s 已存在 %d 条内容不同的记录，无法确定要处理的记录", recordType, name, len(candidates)))
+	}
+
+	if matched != nil {
+		m.claimed[matched.ID] = true
+	}
+	return matched, nil
+}
+
+// reserved 检查现有记录是否与其他同名同类型配置的内容相同
+func (m *existingRecordMatcher) reserved(idx int, record DNSRecordInfo) bool {
+	for i, other := range m.configs {
+		if i == idx {
+			continue
+		}
+		if strings.EqualFold(other.Type, record.Type) &&
+			strings.EqualFold(NormalizeRecordName(other.Name, m.zone.Name), record.Name) &&
+			strings.EqualFold(other.Content, record.Content) {
+			return true
+		}
+	}
+	return false
+}
+
+// processRecord 创建单条记录并添加所有权标签，创建返回冲突时按 policy 处理
+func (c *Client) processRecord(ctx context.Context, zone *ZoneInfo, recordConfig DNSRecordConfig, policy ConflictPolicy, ownerTag string) DNSRecordResult {
 	result := DNSRecordResult{
 		Type:    recordConfig.Type,
 		Name:    recordConfig.Name,
 		Content: recordConfig.Content,
+		Action:  RecordActionFailed,
 	}
 
 	c.logger.Debug("创建 DNS 记录",
-		"zone_id", zoneID,
+		"zone_id", zone.ID,
 		"type", recordConfig.Type,
 		"name", recordConfig.Name,
 		"content", recordConfig.Content,
 	)
 
 	// 创建记录
-	record, err := c.CreateDNSRecord(ctx, zoneID, DNSRecordCreateParams(recordConfig))
+	record, err := c.CreateDNSRecord(ctx, zone.ID, DNSRecordCreateParams{
+		Type:    recordConfig.Type,
+		Name:    recordConfig.Name,
+		Content: recordConfig.Content,
+		TTL:     recordConfig.TTL,
+		Proxied: recordConfig.IsProxied(),
+		Comment: recordConfig.Comment,
+		Tags:    mergeTags(recordConfig.Tags, ownerTag),
+	})
+
+	if err != nil && IsConflictError(err) && handlesConflicts(policy) {
+		return c.resolveConflict(ctx, zone, recordConfig, policy, ownerTag, err)
+	}
 
 	if err != nil {
 		c.logger.Error("创建 DNS 记录失败",
@@ -256,6 +505,7 @@ func (c *Client) processRecord(ctx context.Context, zoneID string, recordConfig
 	}
 
 	result.Success = true
+	result.Action = RecordActionCreated
 	result.RecordID = record.ID
 
 	c.logger.Debug("DNS 记录创建成功",
@@ -267,8 +517,335 @@ func (c *Client) processRecord(ctx context.Context, zoneID string, recordConfig
 	return result
 }
 
+// resolveConflict 处理创建时返回冲突的记录，查找冲突的现有记录后按 policy 处理
+func (c *Client) resolveConflict(ctx context.Context, zone *ZoneInfo, recordConfig DNSRecordConfig, policy ConflictPolicy, ownerTag string, createErr error) DNSRecordResult {
+	result := DNSRecordResult{
+		Type:    recordConfig.Type,
+		Name:    recordConfig.Name,
+		Content: recordConfig.Content,
+		Action:  RecordActionFailed,
+		Error:   createErr,
+	}
+
+	existing, err := c.ListDNSRecords(ctx, zone.ID, strings.ToUpper(recordConfig.Type))
+	if err != nil {
+		c.logger.Error("获取已存在的 DNS 记录失败", "type", recordConfig.Type, "name", recordConfig.Name, "error", err)
+		return result
+	}
+
+	record, ok := findConflictingRecord(zone, existing, recordConfig)
+	if !ok {
+		// 与其他类型的记录冲突（例如 CNAME），无法自动处理
+		return result
+	}
+
+	return c.applyConflict(ctx, zone, record, recordConfig, policy, ownerTag)
+}
+
+// applyConflict 按 policy 跳过或更新已存在的记录，处理方式见 conflictAction
+func (c *Client) applyConflict(ctx context.Context, zone *ZoneInfo, record DNSRecordInfo, recordConfig DNSRecordConfig, policy ConflictPolicy, ownerTag string) DNSRecordResult {
+	result := DNSRecordResult{
+		Type:     recordConfig.Type,
+		Name:     recordConfig.Name,
+		Content:  recordConfig.Content,
+		Action:   RecordActionFailed,
+		RecordID: record.ID,
+	}
+
+	action, err := conflictAction(record, recordConfig, policy, ownerTag)
+	if err != nil {
+		result.Error = err
+		return result
+	}
+
+	if action == RecordActionSkipped {
+		c.logger.Debug("DNS 记录已存在且相同，跳过", "record_id", record.ID, "type", record.Type, "name", record.Name)
+		result.Success = true
+		result.Action = RecordActionSkipped
+		result.Error = nil
+		return result
+	}
+
+	// 与创建相同，未配置的 TTL 和 proxied 使用默认值
+	params := recordUpdateParams(record, recordConfig, ownerTag)
+	ttl := desiredTTL(recordConfig)
+	params.TTL = &ttl
+	proxied := recordConfig.IsProxied()
+	params.Proxied = &proxied
+
+	c.logger.Info("DNS 记录已存在且不同，更新记录", "record_id", record.ID, "type", record.Type, "name", record.Name)
+	if _, err := c.UpdateDNSRecord(ctx, zone.ID, record.ID, record.Type, params); err != nil {
+		c.logger.Error("更新 DNS 记录失败", "type", recordConfig.Type, "name", recordConfig.Name, "error", err)
+		result.Error = err
+		return result
+	}
+
+	result.Success = true
+	result.Action = RecordActionUpdated
+	result.Error = nil
+	return result
+}
+
+// conflictAction 决定如何处理创建时已存在的记录，返回 RecordActionSkipped 或 RecordActionUpdated
+// 完全相同（配置了 ownerTag 时还需要带有该标签）的记录视为成功并跳过；其他情况 skip 策略返回冲突错误，
+// update 策略更新记录。配置了 ownerTag 时，内容相同但没有该标签的记录会添加标签，
+// 内容不同且没有该标签的记录不会更新
+func conflictAction(record DNSRecordInfo, recordConfig DNSRecordConfig, policy ConflictPolicy, ownerTag string) (string, error) {
+	same := sameRecord(record, recordConfig)
+	owned := ownerTag == "" || HasTag(record.Tags, ownerTag)
+	if same && owned {
+		return RecordActionSkipped, nil
+	}
+
+	if policy != ConflictUpdate {
+		if same {
+			return "", NewConflictError("创建 DNS 记录", fmt.Sprintf(
+				"%s %s 已存在相同的记录但没有所有权标签 %s，使用 update 策略添加标签", record.Type, record.Name, ownerTag))
+		}
+		return "", NewConflictError("创建 DNS 记录", fmt.Sprintf(
+			"%s %s (现有: %s, TTL %s, proxied %t)",
+			record.Type, record.Name, record.Content, formatRecordTTL(record.TTL), record.Proxied))
+	}
+
+	if !same && !owned {
+		return "", NewConflictError("创建 DNS 记录", fmt.Sprintf(
+			"%s %s 已存在但没有所有权标签 %s，不会更新", record.Type, record.Name, ownerTag))
+	}
+	return RecordActionUpdated, nil
+}
+
+// findConflictingRecord 查找与配置冲突的现有记录，同名记录有多条时优先选择内容相同的记录
+func findConflictingRecord(zone *ZoneInfo, existing []DNSRecordInfo, recordConfig DNSRecordConfig) (DNSRecordInfo, bool) {
+	matched := MatchDNSRecords(existing, DNSRecordSelector{
+		Name: NormalizeRecordName(recordConfig.Name, zone.Name),
+		Type: strings.ToUpper(recordConfig.Type),
+	})
+
+	for _, record := range matched {
+		if strings.EqualFold(record.Content, recordConfig.Content) {
+			return record, true
+		}
+	}
+	if len(matched) == 1 {
+		return matched[0], true
+	}
+	return DNSRecordInfo{}, false
+}
+
+// sameRecord 检查现有记录与配置的 content、ttl 和 proxied 是否相同，
+// 并且包含配置的备注和标签（未配置时不比较）
+func sameRecord(record DNSRecordInfo, recordConfig DNSRecordConfig) bool {
+	if recordConfig.Comment != "" && record.Comment != recordConfig.Comment {
+		return false
+	}
+	for _, tag := range recordConfig.Tags {
+		if !HasTag(record.Tags, tag) {
+			return false
+		}
+	}
+	return strings.EqualFold(record.Content, recordConfig.Content) &&
+		record.TTL == desiredTTL(recordConfig) &&
+		record.Proxied == recordConfig.IsProxied()
+}
+
+// recordUpdateParams 返回将现有记录更新为配置的参数（不包括 TTL）
+// 配置的标签和所有权标签合并到现有标签，未配置备注和 proxied 时保留现有值
+func recordUpdateParams(record DNSRecordInfo, recordConfig DNSRecordConfig, ownerTag string) DNSRecordUpdateParams {
+	params := DNSRecordUpdateParams{
+		Content: &recordConfig.Content,
+		Proxied: recordConfig.Proxied,
+	}
+	if recordConfig.Comment != "" {
+		params.Comment = &recordConfig.Comment
+	}
+	if len(recordConfig.Tags) > 0 || ownerTag != "" {
+		tags := mergeTags(record.Tags, append(slices.Clone(recordConfig.Tags), ownerTag)...)
+		params.Tags = &tags
+	}
+	return params
+}
+
+// recordTTL 返回实际生效的 TTL，未配置时为 1 (auto)
+func recordTTL(ttl float64) float64 {
+	if ttl <= 0 {
+		return 1
+	}
+	return ttl
+}
+
+// desiredTTL 返回记录创建后实际的 TTL，代理记录的 TTL 固定为 1 (auto)
+func desiredTTL(recordConfig DNSRecordConfig) float64 {
+	if recordConfig.IsProxied() {
+		return 1
+	}
+	return recordTTL(recordConfig.TTL)
+}
+
+// formatRecordTTL 格式化 TTL 用于错误信息
+func formatRecordTTL(ttl float64) string {
+	if ttl == 1 {
+		return "auto"
+	}
+	return fmt.Sprintf("%.0f", ttl)
+}
+
+// processUpdateRecord 匹配现有记录并更新为配置中的 content、ttl、proxied、备注和标签
+func (c *Client) processUpdateRecord(ctx context.Context, zone *ZoneInfo, existing []DNSRecordInfo, recordConfig DNSRecordConfig, ownerTag string) DNSRecordResult {
+	result := DNSRecordResult{
+		Type:    recordConfig.Type,
+		Name:    recordConfig.Name,
+		Content: recordConfig.Content,
+		Action:  RecordActionFailed,
+	}
+
+	record, err := matchOwnedRecord(zone, existing, recordConfig, recordConfig.MatchContent, ownerTag)
+	if err != nil {
+		c.logger.Error("匹配 DNS 记录失败", "type", recordConfig.Type, "name", recordConfig.Name, "error", err)
+		result.Error = err
+		return result
+	}
+	result.RecordID = record.ID
+
+	params := recordUpdateParams(record, recordConfig, ownerTag)
+	// 未配置 TTL 时保留现有值
+	if recordConfig.TTL > 0 {
+		params.TTL = &recordConfig.TTL
+	}
+
+	c.logger.Debug("更新 DNS 记录",
+		"record_id", record.ID,
+		"type", record.Type,
+		"name", record.Name,
+		"content", recordConfig.Content,
+	)
+
+	if _, err := c.UpdateDNSRecord(ctx, zone.ID, record.ID, record.Type, params); err != nil {
+		c.logger.Error("更新 DNS 记录失败", "type", recordConfig.Type, "name", recordConfig.Name, "error", err)
+		result.Error = err
+		return result
+	}
+
+	result.Success = true
+	result.Action = RecordActionUpdated
+	return result
+}
+
+// processDeleteRecord 匹配现有记录并删除
+// 未配置 match_content 时使用 content 匹配，两者都为空时只按 name/type 匹配
+func (c *Client) processDeleteRecord(ctx context.Context, zone *ZoneInfo, existing []DNSRecordInfo, recordConfig DNSRecordConfig, ownerTag string) DNSRecordResult {
+	matchContent := recordConfig.MatchContent
+	if matchContent == "" {
+		matchContent = recordConfig.Content
+	}
+
+	result := DNSRecordResult{
+		Type:    recordConfig.Type,
+		Name:    recordConfig.Name,
+		Content: matchContent,
+		Action:  RecordActionFailed,
+	}
+
+	record, err := matchOwnedRecord(zone, existing, recordConfig, matchContent, ownerTag)
+	if err != nil {
+		c.logger.Error("匹配 DNS 记录失败", "type", recordConfig.Type, "name", recordConfig.Name, "error", err)
+		result.Error = err
+		return result
+	}
+	result.RecordID = record.ID
+	result.Content = record.Content
+
+	c.logger.Debug("删除 DNS 记录", "record_id", record.ID, "type", record.Type, "name", record.Name)
+
+	if err := c.DeleteDNSRecord(ctx, zone.ID, record.ID); err != nil {
+		c.logger.Error("删除 DNS 记录失败", "type", recordConfig.Type, "name", recordConfig.Name, "error", err)
+		result.Error = err
+		return result
+	}
+
+	result.Success = true
+	result.Action = RecordActionDeleted
+	return result
+}
+
+// matchBatchRecord 按 name/type 和可选的内容在现有记录中查找唯一的一条记录
+func matchBatchRecord(zone *ZoneInfo, existing []DNSRecordInfo, recordConfig DNSRecordConfig, matchContent string) (DNSRecordInfo, error) {
+	selector := DNSRecordSelector{
+		Name: NormalizeRecordName(recordConfig.Name, zone.Name),
+		Type: strings.ToUpper(recordConfig.Type),
+	}
+
+	matched := MatchDNSRecords(existing, selector)
+	if matchContent != "" {
+		filtered := matched[:0:0]
+		for _, record := range matched {
+			if strings.EqualFold(record.Content, matchContent) {
+				filtered = append(filtered, record)
+			}
+		}
+		matched = filtered
+	}
+
+	switch len(matched) {
+	case 0:
+		resource := selector.String()
+		if matchContent != "" {
+			resource = fmt.Sprintf("%s -> %s", resource, matchContent)
+		}
+		return DNSRecordInfo{}, NewNotFoundError("匹配 DNS 记录", resource)
+	case 1:
+		return matched[0], nil
+	default:
+		return DNSRecordInfo{}, NewValidationError("匹配 DNS 记录", fmt.Sprintf(
+			"%s 匹配到 %d 条记录，请使用 match_content 指定要操作的记录", selector.String(), len(matched)))
+	}
+}
+
+// matchOwnedRecord 在带有所有权标签的记录中查找唯一的一条记录，ownerTag 为空时匹配所有记录
+// 只有不带所有权标签的记录匹配时返回验证错误，避免操作其他团队或工具管理的记录
+func matchOwnedRecord(zone *ZoneInfo, existing []DNSRecordInfo, recordConfig DNSRecordConfig, matchContent, ownerTag string) (DNSRecordInfo, error) {
+	if ownerTag == "" {
+		return matchBatchRecord(zone, existing, recordConfig, matchContent)
+	}
+
+	record, err := matchBatchRecord(zone, FilterDNSRecordsByTags(existing, []string{ownerTag}), recordConfig, matchContent)
+	if err == nil || !IsNotFoundError(err) {
+		return record, err
+	}
+
+	if _, allErr := matchBatchRecord(zone, existing, recordConfig, matchContent); !IsNotFoundError(allErr) {
+		selector := DNSRecordSelector{
+			Name: NormalizeRecordName(recordConfig.Name, zone.Name),
+			Type: strings.ToUpper(recordConfig.Type),
+		}
+		return DNSRecordInfo{}, NewValidationError("匹配 DNS 记录", fmt.Sprintf(
+			"%s 没有所有权标签 %s，不会修改", selector.String(), ownerTag))
+	}
+	return DNSRecordInfo{}, err
+}
+
 // BatchCreateDNSRecordsConcurrent 并发批量创建 DNS 记录
 func (c *Client) BatchCreateDNSRecordsConcurrent(ctx context.Context, config *DNSBatchConfig, maxConcurrency int, progressCallback func(string)) (*DNSBatchResult, error) {
+	return c.batchDNSRecordsConcurrent(ctx, DNSBatchCreate, config, maxConcurrency, progressCallback)
+}
+
+// BatchUpdateDNSRecords 并发批量更新 DNS 记录
+// 按 name/type（以及可选的 match_content）匹配现有记录，将其更新为配置中的 content、ttl 和 proxied
+func (c *Client) BatchUpdateDNSRecords(ctx context.Context, config *DNSBatchConfig, maxConcurrency int, progressCallback func(string)) (*DNSBatchResult, error) {
+	return c.batchDNSRecordsConcurrent(ctx, DNSBatchUpdate, config, maxConcurrency, progressCallback)
+}
+
+// BatchDeleteDNSRecords 并发批量删除 DNS 记录
+// 按 name/type（以及可选的 match_content 或 content）匹配现有记录
+func (c *Client) BatchDeleteDNSRecords(ctx context.Context, config *DNSBatchConfig, maxConcurrency int, progressCallback func(string)) (*DNSBatchResult, error) {
+	return c.batchDNSRecordsConcurrent(ctx, DNSBatchDelete, config, maxConcurrency, progressCallback)
+}
+
+// batchDNSRecordsConcurrent 按操作类型并发处理批量配置，结果顺序与配置一致
+func (c *Client) batchDNSRecordsConcurrent(ctx context.Context, op DNSBatchOperation, config *DNSBatchConfig, maxConcurrency int, progressCallback func(string)) (*DNSBatchResult, error) {
+	if maxConcurrency < 1 {
+		maxConcurrency = 1
+	}
+
 	result := &DNSBatchResult{
 		TotalZones:   len(config.Zones),
 		TotalRecords: 0,
@@ -281,7 +858,7 @@ func (c *Client) BatchCreateDNSRecordsConcurrent(ctx context.Context, config *DN
 		result.TotalRecords += len(zone.Records)
 	}
 
-	c.logger.Info("开始并发批量创建 DNS 记录",
+	c.logger.Info("开始并发批量"+op.Label()+" DNS 记录",
 		"total_zones", result.TotalZones,
 		"total_records", result.TotalRecords,
 		"max_concurrency", maxConcurrency,
@@ -306,7 +883,7 @@ func (c *Client) BatchCreateDNSRecordsConcurrent(ctx context.Context, config *DN
 					idx+1, result.TotalZones, cfg.Zone))
 			}
 
-			result.ZoneResults[idx] = c.processZone(ctx, cfg, progressCallback)
+			result.ZoneResults[idx] = c.processZone(ctx, op, config.OnConflict, config.OwnerTag, cfg, progressCallback)
 		}(i, zoneConfig)
 	}
 
@@ -326,7 +903,7 @@ func (c *Client) BatchCreateDNSRecordsConcurrent(ctx context.Context, config *DN
 	result.EndTime = time.Now()
 	result.Duration = result.EndTime.Sub(result.StartTime)
 
-	c.logger.Info("并发批量创建完成",
+	c.logger.Info("并发批量"+op.Label()+"完成",
 		"success_zones", result.SuccessZones,
 		"failed_zones", result.FailedZones,
 		"success_records", result.SuccessRecords,
@@ -1,9 +1,20 @@
 package cloudflare
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"reflect"
 	"testing"
+
+	"github.com/cloudflare/cloudflare-go/v2"
+	"github.com/cloudflare/cloudflare-go/v2/option"
 )
 
 // TestLoadDNSBatchConfig 测试加载批量配置
@@ -72,7 +83,7 @@ func TestLoadDNSBatchConfig(t *testing.T) {
 	if record.TTL != 3600 {
 		t.Errorf("Record.TTL = %f, want %f", record.TTL, 3600.0)
 	}
-	if !record.Proxied {
+	if !record.IsProxied() {
 		t.Error("Record.Proxied should be true")
 	}
 }
@@ -128,7 +139,7 @@ func TestDNSBatchConfigValidate(t *testing.T) {
 								Name:    "www",
 								Content: "1.2.3.4",
 								TTL:     3600,
-								Proxied: true,
+								Proxied: boolPtr(true),
 							},
 						},
 					},
@@ -257,6 +268,77 @@ func TestDNSBatchConfigValidate(t *testing.T) {
 	}
 }
 
+// TestDNSBatchConfigValidateFor 测试按操作类型验证配置
+func TestDNSBatchConfigValidateFor(t *testing.T) {
+	config := DNSBatchConfig{
+		Zones: []DNSZoneConfig{
+			{
+				Zone: "example.com",
+				Records: []DNSRecordConfig{
+					{Type: "A", Name: "old"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		op      DNSBatchOperation
+		wantErr bool
+	}{
+		{"创建需要 content", DNSBatchCreate, true},
+		{"更新需要 content", DNSBatchUpdate, true},
+		{"删除不需要 content", DNSBatchDelete, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := config.ValidateFor(tt.op)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateFor(%s) error = %v, wantErr %v", tt.op, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestMatchBatchRecord 测试批量更新和删除的记录匹配
+func TestMatchBatchRecord(t *testing.T) {
+	zone := &ZoneInfo{ID: "zone-id", Name: "example.com"}
+	existing := []DNSRecordInfo{
+		{ID: "1", Type: "A", Name: "www.example.com", Content: "192.0.2.1"},
+		{ID: "2", Type: "A", Name: "www.example.com", Content: "192.0.2.2"},
+		{ID: "3", Type: "CNAME", Name: "blog.example.com", Content: "example.com"},
+		{ID: "4", Type: "A", Name: "example.com", Content: "192.0.2.10"},
+	}
+
+	tests := []struct {
+		name         string
+		record       DNSRecordConfig
+		matchContent string
+		wantID       string
+		wantErr      bool
+	}{
+		{"相对名称唯一匹配", DNSRecordConfig{Type: "CNAME", Name: "blog"}, "", "3", false},
+		{"根域名", DNSRecordConfig{Type: "A", Name: "@"}, "", "4", false},
+		{"多条记录需要 match_content", DNSRecordConfig{Type: "A", Name: "www"}, "", "", true},
+		{"按内容区分多条记录", DNSRecordConfig{Type: "A", Name: "www.example.com"}, "192.0.2.2", "2", false},
+		{"内容不匹配", DNSRecordConfig{Type: "A", Name: "www"}, "192.0.2.9", "", true},
+		{"类型不匹配", DNSRecordConfig{Type: "AAAA", Name: "blog"}, "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record, err := matchBatchRecord(zone, existing, tt.record, tt.matchContent)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("matchBatchRecord() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && record.ID != tt.wantID {
+				t.Errorf("matchBatchRecord() ID = %s, want %s", record.ID, tt.wantID)
+			}
+		})
+	}
+}
+
 // TestDNSBatchResult 测试批量结果结构
 func TestDNSBatchResult(t *testing.T) {
 	result := DNSBatchResult{
@@ -331,3 +413,352 @@ func TestDNSRecordResult(t *testing.T) {
 		t.Errorf("RecordID = %s, want %s", result.RecordID, "test-record-id")
 	}
 }
+
+// TestParseConflictPolicy 测试冲突处理策略解析
+func TestParseConflictPolicy(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    ConflictPolicy
+		wantErr bool
+	}{
+		{"", ConflictFail, false},
+		{"fail", ConflictFail, false},
+		{"skip", ConflictSkip, false},
+		{" Update ", ConflictUpdate, false},
+		{"replace", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseConflictPolicy(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseConflictPolicy(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseConflictPolicy(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+// TestFindConflictingRecord 测试查找冲突的现有记录
+func TestFindConflictingRecord(t *testing.T) {
+	zone := &ZoneInfo{ID: "zone-id", Name: "example.com"}
+	existing := []DNSRecordInfo{
+		{ID: "1", Type: "A", Name: "www.example.com", Content: "192.0.2.1", TTL: 1, Proxied: true},
+		{ID: "2", Type: "A", Name: "www.example.com", Content: "192.0.2.2", TTL: 1, Proxied: true},
+		{ID: "3", Type: "CNAME", Name: "blog.example.com", Content: "old.example.com", TTL: 300},
+	}
+
+	tests := []struct {
+		name     string
+		record   DNSRecordConfig
+		wantID   string
+		wantOK   bool
+		wantSame bool
+	}{
+		{"内容相同的记录", DNSRecordConfig{Type: "A", Name: "www", Content: "192.0.2.2", Proxied: boolPtr(true)}, "2", true, true},
+		{"内容相同但代理不同", DNSRecordConfig{Type: "A", Name: "www", Content: "192.0.2.1"}, "1", true, false},
+		{"唯一的同名记录", DNSRecordConfig{Type: "CNAME", Name: "blog", Content: "new.example.com", TTL: 300}, "3", true, false},
+		{"TTL 不同", DNSRecordConfig{Type: "CNAME", Name: "blog", Content: "old.example.com"}, "3", true, false},
+		{"代理记录配置的 TTL 不生效", DNSRecordConfig{Type: "A", Name: "www", Content: "192.0.2.2", TTL: 3600, Proxied: boolPtr(true)}, "2", true, true},
+		{"缺少配置的标签", DNSRecordConfig{Type: "CNAME", Name: "blog", Content: "old.example.com", TTL: 300, Tags: []string{"team:web"}}, "3", true, false},
+		{"多条同名记录且内容都不同", DNSRecordConfig{Type: "A", Name: "www", Content: "192.0.2.9"}, "", false, false},
+		{"没有同名同类型记录", DNSRecordConfig{Type: "A", Name: "blog", Content: "192.0.2.1"}, "", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record, ok := findConflictingRecord(zone, existing, tt.record)
+			if ok != tt.wantOK {
+				t.Fatalf("findConflictingRecord() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if record.ID != tt.wantID {
+				t.Errorf("findConflictingRecord() ID = %s, want %s", record.ID, tt.wantID)
+			}
+			if same := sameRecord(record, tt.record); same != tt.wantSame {
+				t.Errorf("sameRecord() = %v, want %v", same, tt.wantSame)
+			}
+		})
+	}
+}
+
+// TestConflictAction 测试已存在记录的处理方式和所有权标签
+func TestConflictAction(t *testing.T) {
+	owned := DNSRecordInfo{ID: "1", Type: "A", Name: "www.example.com", Content: "192.0.2.1", TTL: 1, Tags: []string{"managed-by:cloudctl"}}
+	unowned := DNSRecordInfo{ID: "2", Type: "A", Name: "www.example.com", Content: "192.0.2.1", TTL: 1}
+	same := DNSRecordConfig{Type: "A", Name: "www", Content: "192.0.2.1"}
+	changed := DNSRecordConfig{Type: "A", Name: "www", Content: "192.0.2.2"}
+	const ownerTag = "managed-by:cloudctl"
+
+	tests := []struct {
+		name       string
+		record     DNSRecordInfo
+		config     DNSRecordConfig
+		policy     ConflictPolicy
+		ownerTag   string
+		wantAction string
+	}{
+		{"相同记录跳过", unowned, same, ConflictSkip, "", RecordActionSkipped},
+		{"带有所有权标签的相同记录跳过", owned, same, ConflictSkip, ownerTag, RecordActionSkipped},
+		{"skip 策略下没有所有权标签的相同记录报告冲突", unowned, same, ConflictSkip, ownerTag, ""},
+		{"update 策略为相同记录添加所有权标签", unowned, same, ConflictUpdate, ownerTag, RecordActionUpdated},
+		{"skip 策略下不同记录报告冲突", owned, changed, ConflictSkip, ownerTag, ""},
+		{"update 策略更新带有所有权标签的记录", owned, changed, ConflictUpdate, ownerTag, RecordActionUpdated},
+		{"update 策略不更新没有所有权标签的不同记录", unowned, changed, ConflictUpdate, ownerTag, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			action, err := conflictAction(tt.record, tt.config, tt.policy, tt.ownerTag)
+			if tt.wantAction == "" {
+				if err == nil || !IsConflictError(err) {
+					t.Fatalf("conflictAction() error = %v, want conflict error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("conflictAction() error = %v", err)
+			}
+			if action != tt.wantAction {
+				t.Errorf("conflictAction() = %s, want %s", action, tt.wantAction)
+			}
+		})
+	}
+}
+
+// TestMatchOwnedRecord 测试按所有权标签匹配记录
+func TestMatchOwnedRecord(t *testing.T) {
+	zone := &ZoneInfo{ID: "zone-id", Name: "example.com"}
+	existing := []DNSRecordInfo{
+		{ID: "1", Type: "A", Name: "www.example.com", Content: "192.0.2.1", Tags: []string{"managed-by:cloudctl"}},
+		{ID: "2", Type: "A", Name: "www.example.com", Content: "192.0.2.2", Tags: []string{"managed-by:terraform"}},
+		{ID: "3", Type: "CNAME", Name: "blog.example.com", Content: "example.com"},
+	}
+
+	tests := []struct {
+		name     string
+		record   DNSRecordConfig
+		ownerTag string
+		wantID   string
+		wantErr  func(error) bool
+	}{
+		{"未配置所有权标签时多条记录需要 match_content", DNSRecordConfig{Type: "A", Name: "www"}, "", "", IsValidationError},
+		{"只匹配带有所有权标签的记录", DNSRecordConfig{Type: "A", Name: "www"}, "managed-by:cloudctl", "1", nil},
+		{"记录没有所有权标签", DNSRecordConfig{Type: "CNAME", Name: "blog"}, "managed-by:cloudctl", "", IsValidationError},
+		{"记录不存在", DNSRecordConfig{Type: "A", Name: "api"}, "managed-by:cloudctl", "", IsNotFoundError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record, err := matchOwnedRecord(zone, existing, tt.record, "", tt.ownerTag)
+			if tt.wantErr != nil {
+				if err == nil || !tt.wantErr(err) {
+					t.Fatalf("matchOwnedRecord() error = %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("matchOwnedRecord() error = %v", err)
+			}
+			if record.ID != tt.wantID {
+				t.Errorf("matchOwnedRecord() ID = %s, want %s", record.ID, tt.wantID)
+			}
+		})
+	}
+}
+
+// TestRecordUpdateParams 测试更新参数中的 proxied、备注和标签
+func TestRecordUpdateParams(t *testing.T) {
+	record := DNSRecordInfo{ID: "1", Type: "A", Name: "www.example.com", Content: "192.0.2.1", Proxied: true, Comment: "web", Tags: []string{"team:web"}}
+
+	tests := []struct {
+		name        string
+		record      DNSRecordConfig
+		ownerTag    string
+		wantProxied *bool
+		wantComment *string
+		wantTags    []string
+	}{
+		{"未配置 proxied、备注和标签时保留现有值", DNSRecordConfig{Content: "192.0.2.2"}, "", nil, nil, nil},
+		{"配置关闭代理", DNSRecordConfig{Content: "192.0.2.2", Proxied: boolPtr(false)}, "", boolPtr(false), nil, nil},
+		{"合并所有权标签", DNSRecordConfig{Content: "192.0.2.2"}, "managed-by:cloudctl", nil, nil, []string{"team:web", "managed-by:cloudctl"}},
+		{"合并配置的标签并修改备注", DNSRecordConfig{Content: "192.0.2.2", Comment: "new", Tags: []string{"TEAM:web", "env:prod"}}, "", nil, strPtr("new"), []string{"team:web", "env:prod"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := recordUpdateParams(record, tt.record, tt.ownerTag)
+			if !reflect.DeepEqual(params.Proxied, tt.wantProxied) {
+				t.Errorf("Proxied = %v, want %v", params.Proxied, tt.wantProxied)
+			}
+			if !reflect.DeepEqual(params.Comment, tt.wantComment) {
+				t.Errorf("Comment = %v, want %v", params.Comment, tt.wantComment)
+			}
+			var tags []string
+			if params.Tags != nil {
+				tags = *params.Tags
+			}
+			if !reflect.DeepEqual(tags, tt.wantTags) {
+				t.Errorf("Tags = %v, want %v", tags, tt.wantTags)
+			}
+		})
+	}
+}
+
+// TestDesiredTTL 测试代理记录的 TTL 固定为自动
+func TestDesiredTTL(t *testing.T) {
+	tests := []struct {
+		name   string
+		record DNSRecordConfig
+		want   float64
+	}{
+		{"未配置 TTL", DNSRecordConfig{}, 1},
+		{"配置 TTL", DNSRecordConfig{TTL: 3600}, 3600},
+		{"代理记录忽略 TTL", DNSRecordConfig{TTL: 3600, Proxied: boolPtr(true)}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := desiredTTL(tt.record); got != tt.want {
+				t.Errorf("desiredTTL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+// TestExistingRecordMatcher 测试创建前配对同名同类型的现有记录
+func TestExistingRecordMatcher(t *testing.T) {
+	zone := &ZoneInfo{ID: "zone-id", Name: "example.com"}
+
+	tests := []struct {
+		name     string
+		existing []DNSRecordInfo
+		configs  []DNSRecordConfig
+		wantIDs  []string // 每条配置配对的记录 ID，空字符串表示没有配对，"error" 表示冲突错误
+	}{
+		{
+			name:     "内容不同的唯一 A 记录",
+			existing: []DNSRecordInfo{{ID: "1", Type: "A", Name: "www.example.com", Content: "192.0.2.1"}},
+			configs:  []DNSRecordConfig{{Type: "A", Name: "www", Content: "192.0.2.2"}},
+			wantIDs:  []string{"1"},
+		},
+		{
+			name:     "没有同名记录",
+			existing: []DNSRecordInfo{{ID: "1", Type: "A", Name: "api.example.com", Content: "192.0.2.1"}},
+			configs:  []DNSRecordConfig{{Type: "A", Name: "www", Content: "192.0.2.1"}},
+			wantIDs:  []string{""},
+		},
+		{
+			name:     "轮询配置不覆盖其他配置的记录",
+			existing: []DNSRecordInfo{{ID: "1", Type: "A", Name: "www.example.com", Content: "192.0.2.1"}},
+			configs: []DNSRecordConfig{
+				{Type: "A", Name: "www", Content: "192.0.2.2"},
+				{Type: "A", Name: "www", Content: "192.0.2.1"},
+			},
+			wantIDs: []string{"", "1"},
+		},
+		{
+			name: "多条内容都不同的记录",
+			existing: []DNSRecordInfo{
+				{ID: "1", Type: "A", Name: "www.example.com", Content: "192.0.2.1"},
+				{ID: "2", Type: "A", Name: "www.example.com", Content: "192.0.2.2"},
+			},
+			configs: []DNSRecordConfig{{Type: "A", Name: "www", Content: "192.0.2.3"}},
+			wantIDs: []string{"error"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matcher := newExistingRecordMatcher(zone, tt.existing, tt.configs)
+			for i, want := range tt.wantIDs {
+				record, err := matcher.match(i)
+				got := ""
+				switch {
+				case err != nil:
+					if !IsConflictError(err) {
+						t.Fatalf("match(%d) error = %v", i, err)
+					}
+					got = "error"
+				case record != nil:
+					got = record.ID
+				}
+				if got != want {
+					t.Errorf("match(%d) = %q, want %q", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+// TestProcessCreateRecordDifferentContent 测试创建时已存在内容不同的 A 记录：
+// update 策略更新该记录，skip 策略报告冲突，两者都不会创建新的轮询记录
+func TestProcessCreateRecordDifferentContent(t *testing.T) {
+	const existingRecord = `{"id":"rec-1","type":"A","name":"www.example.com","content":"192.0.2.1","ttl":1,"proxied":false,"proxiable":true,"tags":[]}`
+
+	var created, updated int
+	var updatedContent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/zones/zone-id/dns_records":
+			created++
+			fmt.Fprint(w, `{"success":true,"errors":[],"messages":[],"result":{"id":"rec-2","type":"A","name":"www.example.com","content":"192.0.2.2","ttl":1}}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/zones/zone-id/dns_records/rec-1":
+			fmt.Fprintf(w, `{"success":true,"errors":[],"messages":[],"result":%s}`, existingRecord)
+		case r.Method == http.MethodPut && r.URL.Path == "/zones/zone-id/dns_records/rec-1":
+			updated++
+			var body struct {
+				Content string `json:"content"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			updatedContent = body.Content
+			fmt.Fprintf(w, `{"success":true,"errors":[],"messages":[],"result":{"id":"rec-1","type":"A","name":"www.example.com","content":%q,"ttl":1}}`, body.Content)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := &Client{
+		api:    cloudflare.NewClient(option.WithAPIToken("test-token"), option.WithBaseURL(server.URL+"/"), option.WithMaxRetries(0)),
+		logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+	zone := &ZoneInfo{ID: "zone-id", Name: "example.com"}
+	existing := []DNSRecordInfo{{ID: "rec-1", Type: "A", Name: "www.example.com", Content: "192.0.2.1", TTL: 1}}
+	configs := []DNSRecordConfig{{Type: "A", Name: "www", Content: "192.0.2.2"}}
+
+	t.Run("update", func(t *testing.T) {
+		matcher := newExistingRecordMatcher(zone, existing, configs)
+		result := client.processCreateRecord(context.Background(), zone, matcher, 0, configs[0], ConflictUpdate, "")
+		if !result.Success || result.Action != RecordActionUpdated || result.RecordID != "rec-1" {
+			t.Fatalf("processCreateRecord() = %+v, want updated rec-1", result)
+		}
+		if updated != 1 || updatedContent != "192.0.2.2" {
+			t.Errorf("updated = %d, content = %q, want 1 update to 192.0.2.2", updated, updatedContent)
+		}
+	})
+
+	t.Run("skip", func(t *testing.T) {
+		matcher := newExistingRecordMatcher(zone, existing, configs)
+		result := client.processCreateRecord(context.Background(), zone, matcher, 0, configs[0], ConflictSkip, "")
+		if result.Success || !IsConflictError(result.Error) {
+			t.Fatalf("processCreateRecord() = %+v, want conflict error", result)
+		}
+	})
+
+	if created != 0 {
+		t.Errorf("created = %d, want 0", created)
+	}
+}
@@ -0,0 +1,184 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DNSReplaceResult 单条记录的替换结果
+type DNSReplaceResult struct {
+	Zone       string
+	ZoneID     string
+	RecordID   string
+	Type       string
+	Name       string
+	OldContent string
+	NewContent string
+	Success    bool
+	Error      error
+}
+
+// RollbackEntry 回滚日志中的一条记录，包含恢复记录所需的全部信息
+type RollbackEntry struct {
+	Time       time.Time `json:"time"`
+	Zone       string    `json:"zone"`
+	ZoneID     string    `json:"zone_id"`
+	RecordID   string    `json:"record_id"`
+	Type       string    `json:"type"`
+	Name       string    `json:"name"`
+	OldContent string    `json:"old_content"`
+	NewContent string    `json:"new_content"`
+}
+
+// RollbackLog 以 JSON Lines 格式追加写入的回滚日志，可并发写入
+type RollbackLog struct {
+	mu   sync.Mutex
+	file *os.File
+	enc  *json.Encoder
+}
+
+// NewRollbackLog 创建回滚日志，文件已存在时追加写入
+func NewRollbackLog(filename string) (*RollbackLog, error) {
+	file, err := os.OpenFile(filename, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("创建回滚日志失败: %w", err)
+	}
+	return &RollbackLog{file: file, enc: json.NewEncoder(file)}, nil
+}
+
+// Write 写入一条回滚记录
+func (l *RollbackLog) Write(entry RollbackEntry) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := l.enc.Encode(entry); err != nil {
+		return fmt.Errorf("写入回滚日志失败: %w", err)
+	}
+	return nil
+}
+
+// Close 关闭回滚日志
+func (l *RollbackLog) Close() error {
+	return l.file.Close()
+}
+
+// FindDNSRecordsByContent 在多个 Zone 中查找内容与 content 完全相同的记录（不区分大小写）
+func (c *Client) FindDNSRecordsByContent(ctx context.Context, content, recordType string, zones []string, concurrency int, progressCallback func(string)) (*DNSSearchResult, error) {
+	if strings.TrimSpace(content) == "" {
+		return nil, NewValidationError("查找 DNS 记录", "记录内容不能为空")
+	}
+
+	return c.SearchDNSRecords(ctx, DNSSearchOptions{
+		Content:     "^" + regexp.QuoteMeta(content) + "$",
+		Type:        recordType,
+		Regex:       true,
+		Zones:       zones,
+		Concurrency: concurrency,
+	}, progressCallback)
+}
+
+// SplitReplaceableMatches 将匹配的记录分为可以替换内容的记录和记录类型不支持更新的记录
+func SplitReplaceableMatches(matches []DNSSearchMatch) (replaceable, unsupported []DNSSearchMatch) {
+	for _, match := range matches {
+		if restorable(match.Record.Type) {
+			replaceable = append(replaceable, match)
+		} else {
+			unsupported = append(unsupported, match)
+		}
+	}
+	return replaceable, unsupported
+}
+
+// ReplaceDNSRecordContent 并发将匹配记录的内容替换为 newContent，结果顺序与 matches 一致
+// 每条记录更新成功后立即写入回滚日志，rollbackLog 为 nil 时不记录
+func (c *Client) ReplaceDNSRecordContent(ctx context.Context, matches []DNSSearchMatch, newContent string, maxConcurrency int, rollbackLog *RollbackLog, progressCallback func(string)) []DNSReplaceResult {
+	if maxConcurrency < 1 {
+		maxConcurrency = 1
+	}
+
+	c.logger.Info("开始替换 DNS 记录内容", "records", len(matches), "to", newContent, "max_concurrency", maxConcurrency)
+
+	results := make([]DNSReplaceResult, len(matches))
+
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, maxConcurrency)
+
+	for i, match := range matches {
+		wg.Add(1)
+		go func(idx int, match DNSSearchMatch) {
+			defer wg.Done()
+
+			// 获取信号量
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			record := match.Record
+			result := DNSReplaceResult{
+				Zone:       match.Zone,
+				ZoneID:     match.ZoneID,
+				RecordID:   record.ID,
+				Type:       record.Type,
+				Name:       record.Name,
+				OldContent: record.Content,
+				NewContent: newContent,
+			}
+
+			if progressCallback != nil {
+				progressCallback(fmt.Sprintf("Updating record %d/%d: %s %s", idx+1, len(matches), record.Type, record.Name))
+			}
+
+			_, err := c.UpdateDNSRecord(ctx, match.ZoneID, record.ID, record.Type, DNSRecordUpdateParams{
+				Content: &newContent,
+			})
+			if err != nil {
+				c.logger.Error("替换 DNS 记录内容失败", "zone", match.Zone, "name", record.Name, "error", err)
+				result.Error = err
+				results[idx] = result
+				return
+			}
+			result.Success = true
+
+			if rollbackLog != nil {
+				err := rollbackLog.Write(RollbackEntry{
+					Time:       time.Now(),
+					Zone:       match.Zone,
+					ZoneID:     match.ZoneID,
+					RecordID:   record.ID,
+					Type:       record.Type,
+					Name:       record.Name,
+					OldContent: record.Content,
+					NewContent: newContent,
+				})
+				if err != nil {
+					// 记录已更新，回滚日志写入失败只记录警告
+					c.logger.Warn("写入回滚日志失败", "zone", match.Zone, "record_id", record.ID, "old_content", record.Content, "error", err)
+				}
+			}
+
+			results[idx] = result
+		}(i, match)
+	}
+
+	wg.Wait()
+
+	return results
+}
+
+// GroupMatchesByZone 按 Zone 分组匹配的记录，保持 Zone 首次出现的顺序
+func GroupMatchesByZone(matches []DNSSearchMatch) ([]string, map[string][]DNSSearchMatch) {
+	var order []string
+	groups := make(map[string][]DNSSearchMatch)
+	for _, match := range matches {
+		if _, ok := groups[match.Zone]; !ok {
+			order = append(order, match.Zone)
+		}
+		groups[match.Zone] = append(groups[match.Zone], match)
+	}
+	return order, groups
+}
@@ -0,0 +1,99 @@
+package cloudflare
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestRollbackLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rollback.jsonl")
+
+	log, err := NewRollbackLog(path)
+	if err != nil {
+		t.Fatalf("NewRollbackLog() error = %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := log.Write(RollbackEntry{Zone: "example.com", RecordID: "id", OldContent: "192.0.2.1", NewContent: "192.0.2.2"}); err != nil {
+				t.Errorf("Write() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := log.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	lines := 0
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry RollbackEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("第 %d 行不是有效的 JSON: %v", lines+1, err)
+		}
+		if entry.OldContent != "192.0.2.1" {
+			t.Errorf("OldContent = %s, want 192.0.2.1", entry.OldContent)
+		}
+		lines++
+	}
+	if lines != 10 {
+		t.Errorf("回滚日志行数 = %d, want 10", lines)
+	}
+}
+
+func TestGroupMatchesByZone(t *testing.T) {
+	matches := []DNSSearchMatch{
+		{Zone: "b.com", Record: DNSRecordInfo{ID: "1"}},
+		{Zone: "a.com", Record: DNSRecordInfo{ID: "2"}},
+		{Zone: "b.com", Record: DNSRecordInfo{ID: "3"}},
+	}
+
+	order, groups := GroupMatchesByZone(matches)
+	if len(order) != 2 || order[0] != "b.com" || order[1] != "a.com" {
+		t.Errorf("order = %v, want [b.com a.com]", order)
+	}
+	if len(groups["b.com"]) != 2 || groups["b.com"][1].Record.ID != "3" {
+		t.Errorf("groups[b.com] = %v", groups["b.com"])
+	}
+}
+
+func TestSplitReplaceableMatches(t *testing.T) {
+	matches := []DNSSearchMatch{
+		{Zone: "example.com", Record: DNSRecordInfo{ID: "1", Type: "A", Content: "192.0.2.1"}},
+		{Zone: "example.com", Record: DNSRecordInfo{ID: "2", Type: "MX", Content: "mail.example.com"}},
+		{Zone: "test.com", Record: DNSRecordInfo{ID: "3", Type: "cname", Content: "mail.example.com"}},
+		{Zone: "test.com", Record: DNSRecordInfo{ID: "4", Type: "TXT", Content: "mail.example.com"}},
+	}
+
+	replaceable, unsupported := SplitReplaceableMatches(matches)
+
+	ids := func(matches []DNSSearchMatch) []string {
+		var ids []string
+		for _, match := range matches {
+			ids = append(ids, match.Record.ID)
+		}
+		return ids
+	}
+	if got := ids(replaceable); !reflect.DeepEqual(got, []string{"1", "3"}) {
+		t.Errorf("replaceable = %v, want [1 3]", got)
+	}
+	if got := ids(unsupported); !reflect.DeepEqual(got, []string{"2", "4"}) {
+		t.Errorf("unsupported = %v, want [2 4]", got)
+	}
+}
@@ -0,0 +1,237 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DefaultSearchConcurrency 跨 Zone 搜索的默认并发数
+const DefaultSearchConcurrency = 5
+
+// DNSSearchOptions 跨 Zone 搜索 DNS 记录的选项
+type DNSSearchOptions struct {
+	// Name 记录名称匹配模式，为空时不按名称过滤
+	Name string
+	// Content 记录内容匹配模式，为空时不按内容过滤
+	Content string
+	// Type 记录类型，为空时匹配所有类型
+	Type string
+	// Regex 为 true 时按正则表达式匹配，否则按通配符 (* 和 ?) 匹配
+	Regex bool
+	// Zones 只在这些域名中搜索，为空时搜索所有域名
+	Zones []string
+	// Concurrency 同时查询的 Zone 数量
+	Concurrency int
+}
+
+// DNSSearchMatch 匹配的记录
+type DNSSearchMatch struct {
+	Zone   string
+	ZoneID string
+	Record DNSRecordInfo
+}
+
+// DNSSearchResult 跨 Zone 搜索结果
+type DNSSearchResult struct {
+	Matches []DNSSearchMatch
+	// SearchedZones 搜索的 Zone 数量
+	SearchedZones int
+	// ZoneErrors 查询失败的 Zone 及错误
+	ZoneErrors map[string]error
+}
+
+// DNSRecordMatcher 按名称和内容匹配 DNS 记录
+type DNSRecordMatcher struct {
+	name    *regexp.Regexp
+	content *regexp.Regexp
+}
+
+// NewDNSRecordMatcher 创建记录匹配器，name 和 content 不能同时为空
+// 通配符模式需要匹配完整的值，正则表达式只需匹配部分内容，均不区分大小写
+func NewDNSRecordMatcher(name, content string, regex bool) (*DNSRecordMatcher, error) {
+	if name == "" && content == "" {
+		return nil, NewValidationError("搜索 DNS 记录", "请至少指定名称或内容的匹配模式")
+	}
+
+	matcher := &DNSRecordMatcher{}
+	var err error
+	if name != "" {
+		if matcher.name, err = compileSearchPattern(name, regex); err != nil {
+			return nil, err
+		}
+	}
+	if content != "" {
+		if matcher.content, err = compileSearchPattern(content, regex); err != nil {
+			return nil, err
+		}
+	}
+	return matcher, nil
+}
+
+// Match 检查记录是否匹配
+func (m *DNSRecordMatcher) Match(record DNSRecordInfo) bool {
+	if m.name != nil && !m.name.MatchString(strings.TrimSuffix(record.Name, ".")) {
+		return false
+	}
+	if m.content != nil && !m.content.MatchString(record.Content) {
+		return false
+	}
+	return true
+}
+
+// compileSearchPattern 将通配符或正则表达式编译为不区分大小写的正则表达式
+func compileSearchPattern(pattern string, regex bool) (*regexp.Regexp, error) {
+	expr := pattern
+	if !regex {
+		expr = "^" + globToRegexp(pattern) + "$"
+	}
+
+	re, err := regexp.Compile("(?i)" + expr)
+	if err != nil {
+		return nil, NewValidationError("搜索 DNS 记录", fmt.Sprintf("无效的匹配模式 %s: %v", pattern, err))
+	}
+	return re, nil
+}
+
+// globToRegexp 将通配符模式转换为正则表达式，* 匹配任意字符，? 匹配单个字符
+func globToRegexp(pattern string) string {
+	var b strings.Builder
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	return b.String()
+}
+
+// SearchDNSRecords 在多个 Zone 中并发搜索 DNS 记录
+// 单个 Zone 查询失败不会中断搜索，错误记录在 ZoneErrors 中
+func (c *Client) SearchDNSRecords(ctx context.Context, opts DNSSearchOptions, progressCallback func(string)) (*DNSSearchResult, error) {
+	matcher, err := NewDNSRecordMatcher(opts.Name, opts.Content, opts.Regex)
+	if err != nil {
+		return nil, err
+	}
+
+	zoneList, err := c.ListZones(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(opts.Zones) > 0 {
+		zoneList, err = selectZones(zoneList, opts.Zones)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency < 1 {
+		concurrency = DefaultSearchConcurrency
+	}
+
+	c.logger.Info("开始跨 Zone 搜索 DNS 记录",
+		"zones", len(zoneList),
+		"name", opts.Name,
+		"content", opts.Content,
+		"type", opts.Type,
+		"concurrency", concurrency,
+	)
+
+	matches := make([][]DNSSearchMatch, len(zoneList))
+	zoneErrors := make([]error, len(zoneList))
+
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, concurrency)
+
+	for i, zone := range zoneList {
+		wg.Add(1)
+		go func(idx int, zone ZoneInfo) {
+			defer wg.Done()
+
+			// 获取信号量
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			if progressCallback != nil {
+				progressCallback(fmt.Sprintf("Searching zone %d/%d: %s", idx+1, len(zoneList), zone.Name))
+			}
+
+			records, err := c.ListDNSRecords(ctx, zone.ID, strings.ToUpper(opts.Type))
+			if err != nil {
+				zoneErrors[idx] = err
+				return
+			}
+
+			for _, record := range records {
+				if matcher.Match(record) {
+					matches[idx] = append(matches[idx], DNSSearchMatch{
+						Zone:   zone.Name,
+						ZoneID: zone.ID,
+						Record: record,
+					})
+				}
+			}
+		}(i, zone)
+	}
+
+	wg.Wait()
+
+	result := &DNSSearchResult{
+		SearchedZones: len(zoneList),
+		ZoneErrors:    make(map[string]error),
+	}
+	for i, zone := range zoneList {
+		if zoneErrors[i] != nil {
+			result.ZoneErrors[zone.Name] = zoneErrors[i]
+		}
+		result.Matches = append(result.Matches, matches[i]...)
+	}
+
+	sort.SliceStable(result.Matches, func(i, j int) bool {
+		if result.Matches[i].Zone != result.Matches[j].Zone {
+			return result.Matches[i].Zone < result.Matches[j].Zone
+		}
+		return result.Matches[i].Record.Name < result.Matches[j].Record.Name
+	})
+
+	c.logger.Info("跨 Zone 搜索完成",
+		"zones", result.SearchedZones,
+		"matches", len(result.Matches),
+		"failed_zones", len(result.ZoneErrors),
+	)
+
+	return result, nil
+}
+
+// selectZones 从 Zone 列表中选择指定名称的域名，名称不存在时返回 NotFound 错误
+func selectZones(zoneList []ZoneInfo, names []string) ([]ZoneInfo, error) {
+	byName := make(map[string]ZoneInfo, len(zoneList))
+	for _, zone := range zoneList {
+		byName[strings.ToLower(zone.Name)] = zone
+	}
+
+	selected := make([]ZoneInfo, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(name), "."))
+		if name == "" || seen[name] {
+			continue
+		}
+		zone, ok := byName[name]
+		if !ok {
+			return nil, NewNotFoundError("选择 Zone", name)
+		}
+		seen[name] = true
+		selected = append(selected, zone)
+	}
+	return selected, nil
+}
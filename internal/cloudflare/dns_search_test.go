@@ -0,0 +1,75 @@
+package cloudflare
+
+import (
+	"testing"
+)
+
+func TestDNSRecordMatcher(t *testing.T) {
+	record := DNSRecordInfo{
+		Type:    "CNAME",
+		Name:    "api.example.com",
+		Content: "my-elb-123456.us-east-1.elb.amazonaws.com",
+	}
+
+	tests := []struct {
+		name    string
+		pattern string
+		content string
+		regex   bool
+		want    bool
+		wantErr bool
+	}{
+		{name: "内容通配符", content: "*.elb.amazonaws.com", want: true},
+		{name: "通配符需要匹配完整内容", content: "my-elb", want: false},
+		{name: "内容包含", content: "*elb-123456*", want: true},
+		{name: "名称精确匹配不区分大小写", pattern: "API.example.com", want: true},
+		{name: "名称单字符通配符", pattern: "ap?.example.com", want: true},
+		{name: "名称和内容同时匹配", pattern: "api.*", content: "*.amazonaws.com", want: true},
+		{name: "名称匹配但内容不匹配", pattern: "api.*", content: "*.cloudfront.net", want: false},
+		{name: "正则部分匹配", content: `elb-\d+\.us-east-1`, regex: true, want: true},
+		{name: "正则锚定", pattern: `^www\.`, regex: true, want: false},
+		{name: "通配符中的点按字面匹配", pattern: "api?example.com", want: true},
+		{name: "无效的正则", content: "elb-(", regex: true, wantErr: true},
+		{name: "没有匹配模式", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matcher, err := NewDNSRecordMatcher(tt.pattern, tt.content, tt.regex)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewDNSRecordMatcher() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := matcher.Match(record); got != tt.want {
+				t.Errorf("Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	dot, _ := NewDNSRecordMatcher("api.example.com", "", false)
+	if dot.Match(DNSRecordInfo{Name: "apixexample.com"}) {
+		t.Error("通配符模式中的 . 不应匹配任意字符")
+	}
+}
+
+func TestSelectZones(t *testing.T) {
+	zoneList := []ZoneInfo{
+		{ID: "1", Name: "example.com"},
+		{ID: "2", Name: "test.com"},
+		{ID: "3", Name: "demo.org"},
+	}
+
+	selected, err := selectZones(zoneList, []string{"Test.com", "example.com.", "test.com"})
+	if err != nil {
+		t.Fatalf("selectZones() error = %v", err)
+	}
+	if len(selected) != 2 || selected[0].ID != "2" || selected[1].ID != "1" {
+		t.Errorf("selectZones() = %v", selected)
+	}
+
+	if _, err := selectZones(zoneList, []string{"missing.com"}); !IsNotFoundError(err) {
+		t.Errorf("域名不存在时应返回 NotFound 错误, got %v", err)
+	}
+}
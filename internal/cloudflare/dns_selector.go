@@ -0,0 +1,98 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// DNSRecordSelector 按名称和类型选择 DNS 记录
+type DNSRecordSelector struct {
+	// Name 记录名称，支持相对名称 (www)、完整域名 (www.example.com.) 和 @ (根域名)
+	Name string
+	// Type 记录类型，为空时匹配所有类型
+	Type string
+}
+
+// String 返回选择器的可读描述
+func (s DNSRecordSelector) String() string {
+	if s.Type == "" {
+		return s.Name
+	}
+	return fmt.Sprintf("%s %s", strings.ToUpper(s.Type), s.Name)
+}
+
+// NormalizeRecordName 将记录名称转换为完整域名
+// @ 和空名称表示根域名，以 . 结尾或以 zone 结尾的名称视为完整域名，其余视为相对名称
+func NormalizeRecordName(name, zone string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	zone = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(zone)), ".")
+
+	if name == "" || name == "@" {
+		return zone
+	}
+	if strings.HasSuffix(name, ".") {
+		return strings.TrimSuffix(name, ".")
+	}
+	if name == zone || strings.HasSuffix(name, "."+zone) {
+		return name
+	}
+	return name + "." + zone
+}
+
+// MatchDNSRecords 返回与选择器匹配的记录，名称需已规范化为完整域名
+func MatchDNSRecords(records []DNSRecordInfo, selector DNSRecordSelector) []DNSRecordInfo {
+	var matched []DNSRecordInfo
+	for _, record := range records {
+		if !strings.EqualFold(record.Name, selector.Name) {
+			continue
+		}
+		if selector.Type != "" && !strings.EqualFold(record.Type, selector.Type) {
+			continue
+		}
+		matched = append(matched, record)
+	}
+	return matched
+}
+
+// FindDNSRecords 按选择器查找 Zone 中的 DNS 记录
+// 没有匹配的记录时返回 NotFound 错误；匹配到多条记录且 all 为 false 时返回 Validation 错误，
+// 避免脚本误操作多条记录
+func (c *Client) FindDNSRecords(ctx context.Context, zone *ZoneInfo, selector DNSRecordSelector, all bool) ([]DNSRecordInfo, error) {
+	selector.Name = NormalizeRecordName(selector.Name, zone.Name)
+	selector.Type = strings.ToUpper(selector.Type)
+
+	c.logger.Debug("按名称查找 DNS 记录", "zone", zone.Name, "name", selector.Name, "type", selector.Type)
+
+	records, err := c.ListDNSRecords(ctx, zone.ID, selector.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := MatchDNSRecords(records, selector)
+	if err := checkSelection(matched, selector, all); err != nil {
+		return nil, err
+	}
+
+	return matched, nil
+}
+
+// checkSelection 检查选择结果是否唯一
+func checkSelection(matched []DNSRecordInfo, selector DNSRecordSelector, all bool) error {
+	if len(matched) == 0 {
+		return NewNotFoundError("查找 DNS 记录", selector.String())
+	}
+
+	if len(matched) > 1 && !all {
+		lines := make([]string, 0, len(matched))
+		for _, record := range matched {
+			lines = append(lines, fmt.Sprintf("  %s %s -> %s (ID: %s)", record.Type, record.Name, record.Content, record.ID))
+		}
+		return NewValidationError("查找 DNS 记录", fmt.Sprintf(
+			"%s 匹配到 %d 条记录，请使用 --type 缩小范围或使用 --all 操作全部记录:\n%s",
+			selector.String(), len(matched), strings.Join(lines, "\n"),
+		))
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package cloudflare
+
+import (
+	"testing"
+)
+
+func TestNormalizeRecordName(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		zone  string
+		want  string
+	}{
+		{"根域名 @", "@", "example.com", "example.com"},
+		{"空名称", "", "example.com", "example.com"},
+		{"相对名称", "www", "example.com", "www.example.com"},
+		{"多级相对名称", "api.v2", "example.com", "api.v2.example.com"},
+		{"完整域名", "www.example.com", "example.com", "www.example.com"},
+		{"以点结尾的完整域名", "www.example.com.", "example.com", "www.example.com"},
+		{"以点结尾的其他域名", "www.other.com.", "example.com", "www.other.com"},
+		{"根域名本身", "example.com", "example.com", "example.com"},
+		{"大小写不敏感", "WWW", "Example.COM", "www.example.com"},
+		{"相似后缀不视为完整域名", "myexample.com", "example.com", "myexample.com.example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeRecordName(tt.input, tt.zone); got != tt.want {
+				t.Errorf("NormalizeRecordName(%q, %q) = %q, want %q", tt.input, tt.zone, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchDNSRecords(t *testing.T) {
+	records := []DNSRecordInfo{
+		{ID: "1", Type: "A", Name: "www.example.com", Content: "192.0.2.1"},
+		{ID: "2", Type: "A", Name: "www.example.com", Content: "192.0.2.2"},
+		{ID: "3", Type: "AAAA", Name: "www.example.com", Content: "2001:db8::1"},
+		{ID: "4", Type: "CNAME", Name: "blog.example.com", Content: "example.com"},
+	}
+
+	tests := []struct {
+		name     string
+		selector DNSRecordSelector
+		all      bool
+		wantIDs  []string
+		wantErr  bool
+	}{
+		{"唯一匹配", DNSRecordSelector{Name: "blog.example.com", Type: "CNAME"}, false, []string{"4"}, false},
+		{"类型不区分大小写", DNSRecordSelector{Name: "www.example.com", Type: "aaaa"}, false, []string{"3"}, false},
+		{"多条匹配需要 --all", DNSRecordSelector{Name: "www.example.com", Type: "A"}, false, nil, true},
+		{"多条匹配使用 --all", DNSRecordSelector{Name: "www.example.com", Type: "A"}, true, []string{"1", "2"}, false},
+		{"不指定类型", DNSRecordSelector{Name: "www.example.com"}, true, []string{"1", "2", "3"}, false},
+		{"没有匹配", DNSRecordSelector{Name: "api.example.com"}, true, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched := MatchDNSRecords(records, tt.selector)
+			err := checkSelection(matched, tt.selector, tt.all)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("checkSelection() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if len(matched) != len(tt.wantIDs) {
+				t.Fatalf("匹配到 %d 条记录, want %d", len(matched), len(tt.wantIDs))
+			}
+			for i, id := range tt.wantIDs {
+				if matched[i].ID != id {
+					t.Errorf("matched[%d].ID = %s, want %s", i, matched[i].ID, id)
+				}
+			}
+		})
+	}
+
+	if err := checkSelection(nil, DNSRecordSelector{Name: "x.example.com"}, false); !IsNotFoundError(err) {
+		t.Errorf("没有匹配时应返回 NotFound 错误, got %v", err)
+	}
+	if err := checkSelection(records[:2], DNSRecordSelector{Name: "www.example.com"}, false); !IsValidationError(err) {
+		t.Errorf("多条匹配时应返回 Validation 错误, got %v", err)
+	}
+}
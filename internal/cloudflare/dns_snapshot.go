@@ -0,0 +1,357 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+// DNSSnapshotVersion 快照文件格式版本
+const DNSSnapshotVersion = 1
+
+// 恢复操作类型
+const (
+	RestoreCreate = "create"
+	RestoreUpdate = "update"
+	RestoreDelete = "delete"
+)
+
+// DNSSnapshot DNS 记录快照
+type DNSSnapshot struct {
+	Version   int               `json:"version"`
+	CreatedAt time.Time         `json:"created_at"`
+	Zones     []DNSZoneSnapshot `json:"zones"`
+}
+
+// DNSZoneSnapshot 单个 Zone 的记录快照
+type DNSZoneSnapshot struct {
+	Zone    string          `json:"zone"`
+	ZoneID  string          `json:"zone_id"`
+	Records []DNSRecordInfo `json:"records"`
+}
+
+// DNSRestoreChange 恢复快照需要执行的变更
+type DNSRestoreChange struct {
+	Action string
+	Zone   string
+	ZoneID string
+	// Current 当前的记录，创建时为 nil
+	Current *DNSRecordInfo
+	// Desired 快照中的记录，删除时为 nil
+	Desired *DNSRecordInfo
+	// Unsupported 无法执行的原因（记录类型不支持创建或更新），为空时可以执行
+	Unsupported string
+}
+
+// Record 返回变更涉及的记录，优先返回快照中的记录
+func (c DNSRestoreChange) Record() DNSRecordInfo {
+	if c.Desired != nil {
+		return *c.Desired
+	}
+	return *c.Current
+}
+
+// SplitUnsupportedChanges 将变更分为可以执行和无法执行的变更
+func SplitUnsupportedChanges(changes []DNSRestoreChange) (supported, unsupported []DNSRestoreChange) {
+	for _, change := range changes {
+		if change.Unsupported != "" {
+			unsupported = append(unsupported, change)
+		} else {
+			supported = append(supported, change)
+		}
+	}
+	return supported, unsupported
+}
+
+// DNSRestoreResult 单个变更的执行结果
+type DNSRestoreResult struct {
+	Change  DNSRestoreChange
+	Success bool
+	Error   error
+}
+
+// restorableTypes 可以创建和更新的记录类型，与 CreateDNSRecord 支持的类型一致
+var restorableTypes = []string{"A", "AAAA", "CNAME"}
+
+// SnapshotDNS 获取多个 Zone 的 DNS 记录快照
+func (c *Client) SnapshotDNS(ctx context.Context, domains []string) (*DNSSnapshot, error) {
+	snapshot := &DNSSnapshot{
+		Version:   DNSSnapshotVersion,
+		CreatedAt: time.Now().UTC(),
+		Zones:     make([]DNSZoneSnapshot, 0, len(domains)),
+	}
+
+	for _, domain := range domains {
+		zone, err := c.GetZoneByName(ctx, domain)
+		if err != nil {
+			return nil, err
+		}
+
+		records, err := c.ListDNSRecords(ctx, zone.ID, "")
+		if err != nil {
+			return nil, err
+		}
+
+		snapshot.Zones = append(snapshot.Zones, DNSZoneSnapshot{
+			Zone:    zone.Name,
+			ZoneID:  zone.ID,
+			Records: records,
+		})
+	}
+
+	return snapshot, nil
+}
+
+// SaveDNSSnapshot 将快照写入 JSON 文件
+func SaveDNSSnapshot(filename string, snapshot *DNSSnapshot) error {
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return fmt.Errorf("序列化快照失败: %w", err)
+	}
+
+	if err := os.WriteFile(filename, data, 0644); err != nil {
+		return fmt.Errorf("写入快照文件失败: %w", err)
+	}
+
+	return nil
+}
+
+// LoadDNSSnapshot 从 JSON 文件加载快照
+func LoadDNSSnapshot(filename string) (*DNSSnapshot, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("读取快照文件失败: %w", err)
+	}
+
+	var snapshot DNSSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("解析快照文件失败: %w", err)
+	}
+
+	if snapshot.Version != DNSSnapshotVersion {
+		return nil, fmt.Errorf("不支持的快照版本: %d", snapshot.Version)
+	}
+	if len(snapshot.Zones) == 0 {
+		return nil, fmt.Errorf("快照中没有任何 zone")
+	}
+
+	return &snapshot, nil
+}
+
+// DiffDNSRecords 计算将当前记录恢复为快照状态需要的变更
+// 记录先按 ID 匹配；ID 不存在时（记录被删除后重建）按类型、名称和内容匹配。
+// 名称或类型已变化的记录先删除再创建。返回的变更按删除、更新、创建排序，
+// 避免新记录与待删除的记录冲突（例如 CNAME）。
+// 不支持创建或更新的记录类型的变更会标记 Unsupported，并且不会删除对应的当前记录，
+// 避免删除后无法重建
+func DiffDNSRecords(zone, zoneID string, snapshot, current []DNSRecordInfo) []DNSRestoreChange {
+	var deletes, updates, creates []DNSRestoreChange
+
+	change := func(action string, cur, desired *DNSRecordInfo) DNSRestoreChange {
+		c := DNSRestoreChange{Action: action, Zone: zone, ZoneID: zoneID, Current: cur, Desired: desired}
+		if desired != nil && !restorable(desired.Type) {
+			c.Unsupported = fmt.Sprintf("不支持%s %s 记录", restoreActionName(action), strings.ToUpper(desired.Type))
+		}
+		return c
+	}
+
+	byID := make(map[string]int, len(current))
+	for i, record := range current {
+		byID[record.ID] = i
+	}
+	matched := make([]bool, len(current))
+
+	var unmatched []int
+	for i := range snapshot {
+		desired := &snapshot[i]
+		idx, ok := byID[desired.ID]
+		if !ok {
+			unmatched = append(unmatched, i)
+			continue
+		}
+
+		matched[idx] = true
+		cur := &current[idx]
+		switch {
+		case !sameIdentity(*cur, *desired) && !restorable(desired.Type):
+			// 无法重建时保留当前记录
+			creates = append(creates, change(RestoreCreate, nil, desired))
+		case !sameIdentity(*cur, *desired):
+			deletes = append(deletes, change(RestoreDelete, cur, nil))
+			creates = append(creates, change(RestoreCreate, nil, desired))
+		case !sameAttributes(*cur, *desired):
+			updates = append(updates, change(RestoreUpdate, cur, desired))
+		}
+	}
+
+	// ID 不存在的快照记录按类型、名称和内容匹配未使用的当前记录
+	for _, i := range unmatched {
+		desired := &snapshot[i]
+		idx := -1
+		for j, record := range current {
+			if !matched[j] && sameIdentity(record, *desired) && strings.EqualFold(record.Content, desired.Content) {
+				idx = j
+				break
+			}
+		}
+
+		// 无法重建的记录保留同类型同名称的当前记录（内容不同的重建记录），不删除
+		if idx < 0 && !restorable(desired.Type) {
+			for j, record := range current {
+				if !matched[j] && sameIdentity(record, *desired) {
+					matched[j] = true
+					break
+				}
+			}
+		}
+
+		if idx < 0 {
+			creates = append(creates, change(RestoreCreate, nil, desired))
+			continue
+		}
+
+		matched[idx] = true
+		if !sameAttributes(current[idx], *desired) {
+			updates = append(updates, change(RestoreUpdate, &current[idx], desired))
+		}
+	}
+
+	for i := range current {
+		if !matched[i] {
+			deletes = append(deletes, change(RestoreDelete, &current[i], nil))
+		}
+	}
+
+	changes := make([]DNSRestoreChange, 0, len(deletes)+len(updates)+len(creates))
+	changes = append(changes, deletes...)
+	changes = append(changes, updates...)
+	changes = append(changes, creates...)
+	return changes
+}
+
+// restorable 检查记录类型是否支持创建和更新
+func restorable(recordType string) bool {
+	return slices.Contains(restorableTypes, strings.ToUpper(recordType))
+}
+
+// restoreActionName 返回恢复操作的中文名称
+func restoreActionName(action string) string {
+	switch action {
+	case RestoreCreate:
+		return "创建"
+	case RestoreUpdate:
+		return "更新"
+	default:
+		return "删除"
+	}
+}
+
+// sameIdentity 检查两条记录的类型和名称是否相同
+func sameIdentity(a, b DNSRecordInfo) bool {
+	return strings.EqualFold(a.Type, b.Type) && strings.EqualFold(a.Name, b.Name)
+}
+
+// sameAttributes 检查两条记录的内容、TTL、代理、备注和标签是否相同
+func sameAttributes(a, b DNSRecordInfo) bool {
+	if !strings.EqualFold(a.Content, b.Content) || a.TTL != b.TTL || a.Proxied != b.Proxied || a.Comment != b.Comment {
+		return false
+	}
+
+	tagsA := slices.Clone(a.Tags)
+	tagsB := slices.Clone(b.Tags)
+	slices.Sort(tagsA)
+	slices.Sort(tagsB)
+	return slices.Equal(tagsA, tagsB)
+}
+
+// PlanDNSRestore 获取 Zone 的当前记录并计算恢复快照需要的变更
+// 按名称查找 Zone，Zone 被删除后重新添加（ID 变化）时也可以恢复
+func (c *Client) PlanDNSRestore(ctx context.Context, zoneSnapshot DNSZoneSnapshot) ([]DNSRestoreChange, error) {
+	zone, err := c.GetZoneByName(ctx, zoneSnapshot.Zone)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := c.ListDNSRecords(ctx, zone.ID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return DiffDNSRecords(zone.Name, zone.ID, zoneSnapshot.Records, current), nil
+}
+
+// ApplyDNSRestore 依次执行恢复变更，单个变更失败不影响其他变更
+func (c *Client) ApplyDNSRestore(ctx context.Context, changes []DNSRestoreChange, progressCallback func(string)) []DNSRestoreResult {
+	results := make([]DNSRestoreResult, 0, len(changes))
+
+	for i, change := range changes {
+		record := change.Record()
+		if progressCallback != nil {
+			progressCallback(fmt.Sprintf("%s: %s record %d/%d (%s %s)",
+				change.Zone, change.Action, i+1, len(changes), record.Type, record.Name))
+		}
+
+		err := c.applyRestoreChange(ctx, change)
+		if err != nil {
+			c.logger.Error("恢复 DNS 记录失败",
+				"zone", change.Zone,
+				"action", change.Action,
+				"type", record.Type,
+				"name", record.Name,
+				"error", err,
+			)
+		}
+
+		results = append(results, DNSRestoreResult{
+			Change:  change,
+			Success: err == nil,
+			Error:   err,
+		})
+	}
+
+	return results
+}
+
+// applyRestoreChange 执行单个恢复变更
+func (c *Client) applyRestoreChange(ctx context.Context, change DNSRestoreChange) error {
+	if change.Unsupported != "" {
+		return NewValidationError("恢复 DNS 记录", change.Unsupported)
+	}
+
+	switch change.Action {
+	case RestoreDelete:
+		return c.DeleteDNSRecord(ctx, change.ZoneID, change.Current.ID)
+
+	case RestoreUpdate:
+		desired := change.Desired
+		tags := slices.Clone(desired.Tags)
+		_, err := c.UpdateDNSRecord(ctx, change.ZoneID, change.Current.ID, change.Current.Type, DNSRecordUpdateParams{
+			Content: &desired.Content,
+			TTL:     &desired.TTL,
+			Proxied: &desired.Proxied,
+			Comment: &desired.Comment,
+			Tags:    &tags,
+		})
+		return err
+
+	case RestoreCreate:
+		desired := change.Desired
+		_, err := c.CreateDNSRecord(ctx, change.ZoneID, DNSRecordCreateParams{
+			Type:    strings.ToUpper(desired.Type),
+			Name:    desired.Name,
+			Content: desired.Content,
+			TTL:     desired.TTL,
+			Proxied: desired.Proxied,
+			Comment: desired.Comment,
+			Tags:    desired.Tags,
+		})
+		return err
+
+	default:
+		return fmt.Errorf("未知的恢复操作: %s", change.Action)
+	}
+}
@@ -0,0 +1,205 @@
+package cloudflare
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDiffDNSRecords(t *testing.T) {
+	www := DNSRecordInfo{ID: "1", Type: "A", Name: "www.example.com", Content: "192.0.2.1", TTL: 1, Proxied: true}
+	api := DNSRecordInfo{ID: "2", Type: "CNAME", Name: "api.example.com", Content: "lb.example.net", TTL: 300, Tags: []string{"team:api", "env:prod"}}
+	mx := DNSRecordInfo{ID: "3", Type: "MX", Name: "example.com", Content: "mail.example.com", TTL: 1}
+
+	withContent := func(r DNSRecordInfo, content string) DNSRecordInfo {
+		r.Content = content
+		return r
+	}
+	withID := func(r DNSRecordInfo, id string) DNSRecordInfo {
+		r.ID = id
+		return r
+	}
+
+	type change struct {
+		action      string
+		id          string
+		unsupported bool
+	}
+
+	tests := []struct {
+		name     string
+		snapshot []DNSRecordInfo
+		current  []DNSRecordInfo
+		want     []change
+	}{
+		{
+			name:     "没有变化",
+			snapshot: []DNSRecordInfo{www, api, mx},
+			current:  []DNSRecordInfo{www, api, mx},
+		},
+		{
+			name:     "标签顺序不同视为相同",
+			snapshot: []DNSRecordInfo{api},
+			current:  []DNSRecordInfo{func() DNSRecordInfo { r := api; r.Tags = []string{"env:prod", "team:api"}; return r }()},
+		},
+		{
+			name:     "内容被修改",
+			snapshot: []DNSRecordInfo{www},
+			current:  []DNSRecordInfo{withContent(www, "192.0.2.99")},
+			want:     []change{{RestoreUpdate, "1", false}},
+		},
+		{
+			name:     "记录被删除",
+			snapshot: []DNSRecordInfo{www, api},
+			current:  []DNSRecordInfo{www},
+			want:     []change{{RestoreCreate, "2", false}},
+		},
+		{
+			name:     "新增的记录被删除",
+			snapshot: []DNSRecordInfo{www},
+			current:  []DNSRecordInfo{www, api},
+			want:     []change{{RestoreDelete, "2", false}},
+		},
+		{
+			name:     "记录被删除后重建",
+			snapshot: []DNSRecordInfo{mx},
+			current:  []DNSRecordInfo{withID(mx, "9")},
+		},
+		{
+			name:     "记录重建后内容不同",
+			snapshot: []DNSRecordInfo{api},
+			current:  []DNSRecordInfo{withID(withContent(api, "other.example.net"), "9")},
+			want:     []change{{RestoreDelete, "9", false}, {RestoreCreate, "2", false}},
+		},
+		{
+			name:     "同一 ID 的名称被修改",
+			snapshot: []DNSRecordInfo{www},
+			current:  []DNSRecordInfo{func() DNSRecordInfo { r := www; r.Name = "web.example.com"; return r }()},
+			want:     []change{{RestoreDelete, "1", false}, {RestoreCreate, "1", false}},
+		},
+		{
+			name:     "删除排在更新和创建之前",
+			snapshot: []DNSRecordInfo{withContent(www, "192.0.2.2"), api},
+			current:  []DNSRecordInfo{www, mx},
+			want:     []change{{RestoreDelete, "3", false}, {RestoreUpdate, "1", false}, {RestoreCreate, "2", false}},
+		},
+		{
+			name:     "不支持的类型被修改",
+			snapshot: []DNSRecordInfo{mx},
+			current:  []DNSRecordInfo{withContent(mx, "mail2.example.com")},
+			want:     []change{{RestoreUpdate, "3", true}},
+		},
+		{
+			name:     "不支持的类型被重命名时不删除当前记录",
+			snapshot: []DNSRecordInfo{mx},
+			current:  []DNSRecordInfo{func() DNSRecordInfo { r := mx; r.Name = "mail.example.com"; return r }()},
+			want:     []change{{RestoreCreate, "3", true}},
+		},
+		{
+			name:     "不支持的类型重建后内容不同时不删除当前记录",
+			snapshot: []DNSRecordInfo{mx},
+			current:  []DNSRecordInfo{withID(withContent(mx, "mail2.example.com"), "9")},
+			want:     []change{{RestoreCreate, "3", true}},
+		},
+		{
+			name:     "删除不支持的类型的新增记录",
+			snapshot: []DNSRecordInfo{www},
+			current:  []DNSRecordInfo{www, mx},
+			want:     []change{{RestoreDelete, "3", false}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes := DiffDNSRecords("example.com", "zone-id", tt.snapshot, tt.current)
+
+			var got []change
+			for _, c := range changes {
+				id := c.Record().ID
+				if c.Action == RestoreDelete {
+					id = c.Current.ID
+				}
+				got = append(got, change{c.Action, id, c.Unsupported != ""})
+
+				if c.Zone != "example.com" || c.ZoneID != "zone-id" {
+					t.Errorf("变更的 Zone = %s/%s", c.Zone, c.ZoneID)
+				}
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DiffDNSRecords() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDNSSnapshotSaveLoad(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "snap.json")
+
+	snapshot := &DNSSnapshot{
+		Version:   DNSSnapshotVersion,
+		CreatedAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		Zones: []DNSZoneSnapshot{
+			{
+				Zone:   "example.com",
+				ZoneID: "zone-id",
+				Records: []DNSRecordInfo{
+					{ID: "1", Type: "A", Name: "www.example.com", Content: "192.0.2.1", TTL: 1, Comment: "web", Tags: []string{"team:web"}},
+				},
+			},
+		},
+	}
+
+	if err := SaveDNSSnapshot(path, snapshot); err != nil {
+		t.Fatalf("SaveDNSSnapshot() error = %v", err)
+	}
+
+	loaded, err := LoadDNSSnapshot(path)
+	if err != nil {
+		t.Fatalf("LoadDNSSnapshot() error = %v", err)
+	}
+	if !reflect.DeepEqual(loaded, snapshot) {
+		t.Errorf("LoadDNSSnapshot() = %+v, want %+v", loaded, snapshot)
+	}
+
+	invalid := []struct {
+		name    string
+		content string
+	}{
+		{"版本不支持", `{"version": 2, "zones": [{"zone": "example.com"}]}`},
+		{"没有 zone", `{"version": 1, "zones": []}`},
+		{"无效的 JSON", `{`},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, "invalid.json")
+			if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := LoadDNSSnapshot(path); err == nil {
+				t.Error("LoadDNSSnapshot() 应返回错误")
+			}
+		})
+	}
+}
+
+func TestRecordTags(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want []string
+	}{
+		{`["team:web","env:prod"]`, []string{"team:web", "env:prod"}},
+		{`[]`, nil},
+		{`null`, nil},
+		{``, nil},
+	}
+
+	for _, tt := range tests {
+		if got := recordTags(tt.raw); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("recordTags(%q) = %v, want %v", tt.raw, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package cloudflare
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// DefaultOwnerTag 建议的所有权标签，批量操作配置 owner_tag 时使用
+const DefaultOwnerTag = "managed-by:cloudctl"
+
+// ValidateTag 验证标签格式，标签为 name 或 name:value，不能包含空白字符
+func ValidateTag(tag string) error {
+	name, _, _ := strings.Cut(tag, ":")
+	if name == "" {
+		return fmt.Errorf("无效的标签 %q: 名称不能为空", tag)
+	}
+	if strings.ContainsFunc(tag, func(r rune) bool { return r == ' ' || r == '\t' || r == '\n' }) {
+		return fmt.Errorf("无效的标签 %q: 不能包含空白字符", tag)
+	}
+	return nil
+}
+
+// HasTag 检查标签列表中是否有匹配的标签，不区分大小写
+// tag 为 name:value 时需要完全相同，只有 name 时匹配该名称的任意值
+func HasTag(tags []string, tag string) bool {
+	return slices.ContainsFunc(tags, func(t string) bool {
+		if strings.Contains(tag, ":") {
+			return strings.EqualFold(t, tag)
+		}
+		name, _, _ := strings.Cut(t, ":")
+		return strings.EqualFold(name, tag)
+	})
+}
+
+// FilterDNSRecordsByTags 返回包含所有指定标签的记录，tags 为空时返回全部记录
+func FilterDNSRecordsByTags(records []DNSRecordInfo, tags []string) []DNSRecordInfo {
+	if len(tags) == 0 {
+		return records
+	}
+
+	filtered := make([]DNSRecordInfo, 0, len(records))
+	for _, record := range records {
+		if !slices.ContainsFunc(tags, func(tag string) bool { return !HasTag(record.Tags, tag) }) {
+			filtered = append(filtered, record)
+		}
+	}
+	return filtered
+}
+
+// mergeTags 将 add 中的标签合并到 tags 中，忽略空标签和已存在的标签
+func mergeTags(tags []string, add ...string) []string {
+	merged := slices.Clone(tags)
+	for _, tag := range add {
+		if tag == "" || slices.ContainsFunc(merged, func(t string) bool { return strings.EqualFold(t, tag) }) {
+			continue
+		}
+		merged = append(merged, tag)
+	}
+	return merged
+}
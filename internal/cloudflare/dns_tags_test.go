@@ -0,0 +1,82 @@
+package cloudflare
+
+import (
+	"testing"
+)
+
+func TestHasTag(t *testing.T) {
+	tags := []string{"managed-by:cloudctl", "team:web", "legacy"}
+
+	tests := []struct {
+		tag  string
+		want bool
+	}{
+		{"managed-by:cloudctl", true},
+		{"Managed-By:CloudCtl", true},
+		{"managed-by:terraform", false},
+		{"team", true},
+		{"legacy", true},
+		{"env", false},
+	}
+
+	for _, tt := range tests {
+		if got := HasTag(tags, tt.tag); got != tt.want {
+			t.Errorf("HasTag(%q) = %v, want %v", tt.tag, got, tt.want)
+		}
+	}
+}
+
+func TestFilterDNSRecordsByTags(t *testing.T) {
+	records := []DNSRecordInfo{
+		{ID: "1", Tags: []string{"managed-by:cloudctl", "team:web"}},
+		{ID: "2", Tags: []string{"team:web"}},
+		{ID: "3"},
+	}
+
+	tests := []struct {
+		name string
+		tags []string
+		want []string
+	}{
+		{"不过滤", nil, []string{"1", "2", "3"}},
+		{"按名称过滤", []string{"team"}, []string{"1", "2"}},
+		{"需要包含所有标签", []string{"team:web", "managed-by:cloudctl"}, []string{"1"}},
+		{"没有匹配", []string{"env:prod"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, record := range FilterDNSRecordsByTags(records, tt.tags) {
+				got = append(got, record.ID)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("FilterDNSRecordsByTags() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("FilterDNSRecordsByTags() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateTag(t *testing.T) {
+	tests := []struct {
+		tag     string
+		wantErr bool
+	}{
+		{"managed-by:cloudctl", false},
+		{"legacy", false},
+		{":cloudctl", true},
+		{"", true},
+		{"team:web app", true},
+	}
+
+	for _, tt := range tests {
+		if err := ValidateTag(tt.tag); (err != nil) != tt.wantErr {
+			t.Errorf("ValidateTag(%q) error = %v, wantErr %v", tt.tag, err, tt.wantErr)
+		}
+	}
+}
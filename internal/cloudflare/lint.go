@@ -0,0 +1,195 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+)
+
+// 检查结果的严重程度
+const (
+	LintError   = "error"
+	LintWarning = "warning"
+)
+
+// 检查规则
+const (
+	RuleCNAMEConflict      = "cname-conflict"
+	RuleProxiedUnsupported = "proxied-unsupported"
+	RuleInvalidIP          = "invalid-ip"
+	RulePrivateIP          = "private-ip"
+	RuleTTLProxied         = "ttl-proxied"
+	RuleApexCNAME          = "apex-cname"
+	RuleDanglingCloudFront = "dangling-cloudfront"
+)
+
+// cloudFrontSuffix CloudFront 分发默认域名的后缀
+const cloudFrontSuffix = ".cloudfront.net"
+
+// proxiableTypes 可以启用 Cloudflare 代理的记录类型
+var proxiableTypes = []string{"A", "AAAA", "CNAME"}
+
+// cgnatPrefix 运营商级 NAT 地址段 (RFC 6598)，netip 的 IsPrivate 不包含该地址段
+var cgnatPrefix = netip.MustParsePrefix("100.64.0.0/10")
+
+// LintIssue 检查发现的问题
+type LintIssue struct {
+	Rule     string
+	Severity string
+	Zone     string
+	// RecordIndex 问题记录在输入中的下标
+	RecordIndex int
+	Record      DNSRecordInfo
+	Message     string
+}
+
+// LintOptions 检查选项
+type LintOptions struct {
+	// CloudFrontDomains 现有 CloudFront 分发的域名（小写），为 nil 时不检查指向已删除分发的 CNAME
+	CloudFrontDomains map[string]bool
+}
+
+// HasLintErrors 检查是否存在 error 级别的问题
+func HasLintErrors(issues []LintIssue) bool {
+	return slices.ContainsFunc(issues, func(issue LintIssue) bool {
+		return issue.Severity == LintError
+	})
+}
+
+// RecordsFromConfig 将配置中的记录转换为完整域名的 DNSRecordInfo，用于检查
+func RecordsFromConfig(zone string, records []DNSRecordConfig) []DNSRecordInfo {
+	infos := make([]DNSRecordInfo, 0, len(records))
+	for _, record := range records {
+		infos = append(infos, DNSRecordInfo{
+			Type:    strings.ToUpper(record.Type),
+			Name:    NormalizeRecordName(record.Name, zone),
+			Content: record.Content,
+			TTL:     recordTTL(record.TTL),
+			Proxied: record.IsProxied(),
+			Comment: record.Comment,
+			Tags:    record.Tags,
+		})
+	}
+	return infos
+}
+
+// LintRecords 检查 Zone 中的记录
+func LintRecords(zone string, records []DNSRecordInfo, opts LintOptions) []LintIssue {
+	zone = strings.TrimSuffix(strings.ToLower(zone), ".")
+
+	var issues []LintIssue
+	add := func(idx int, rule, severity, format string, args ...interface{}) {
+		issues = append(issues, LintIssue{
+			Rule:        rule,
+			Severity:    severity,
+			Zone:        zone,
+			RecordIndex: idx,
+			Record:      records[idx],
+			Message:     fmt.Sprintf(format, args...),
+		})
+	}
+
+	// 同名记录的下标，用于检查 CNAME 冲突
+	byName := make(map[string][]int)
+	for i, record := range records {
+		name := strings.TrimSuffix(strings.ToLower(record.Name), ".")
+		byName[name] = append(byName[name], i)
+	}
+
+	for i, record := range records {
+		recordType := strings.ToUpper(record.Type)
+		name := strings.TrimSuffix(strings.ToLower(record.Name), ".")
+
+		if recordType == "CNAME" && len(byName[name]) > 1 {
+			add(i, RuleCNAMEConflict, LintError, "%s 的 CNAME 记录不能与其他记录共存 (共 %d 条同名记录)", name, len(byName[name]))
+		}
+
+		if record.Proxied && !slices.Contains(proxiableTypes, recordType) {
+			add(i, RuleProxiedUnsupported, LintError, "%s 记录不支持 Cloudflare 代理", recordType)
+		}
+
+		if recordType == "A" || recordType == "AAAA" {
+			lintAddress(i, recordType, record.Content, add)
+		}
+
+		if record.Proxied && record.TTL != 0 && record.TTL != 1 {
+			add(i, RuleTTLProxied, LintWarning, "启用代理时 TTL 固定为自动，设置的 TTL %.0f 不会生效", record.TTL)
+		}
+
+		if recordType == "CNAME" && name == zone {
+			add(i, RuleApexCNAME, LintWarning, "根域名的 CNAME 会被 Cloudflare 展平为目标的 A/AAAA 记录，其他服务查询时看不到 CNAME")
+		}
+
+		if recordType == "CNAME" && opts.CloudFrontDomains != nil {
+			target := strings.TrimSuffix(strings.ToLower(record.Content), ".")
+			if strings.HasSuffix(target, cloudFrontSuffix) && !opts.CloudFrontDomains[target] {
+				add(i, RuleDanglingCloudFront, LintError, "CNAME 指向的 CloudFront 分发 %s 不存在", target)
+			}
+		}
+	}
+
+	return issues
+}
+
+// LintNewRecords 检查新记录，现有记录只用于检查冲突，只返回与新记录相关的问题
+// 返回的 RecordIndex 为新记录在 newRecords 中的下标
+func LintNewRecords(zone string, existing, newRecords []DNSRecordInfo, opts LintOptions) []LintIssue {
+	all := make([]DNSRecordInfo, 0, len(existing)+len(newRecords))
+	all = append(all, existing...)
+	all = append(all, newRecords...)
+
+	var issues []LintIssue
+	for _, issue := range LintRecords(zone, all, opts) {
+		if issue.RecordIndex < len(existing) {
+			continue
+		}
+		issue.RecordIndex -= len(existing)
+		issues = append(issues, issue)
+	}
+
+	// 与现有 CNAME 同名的新记录：LintRecords 只在 CNAME 记录上报告冲突，需要在新记录上补充报告
+	existingCNAMEs := make(map[string]bool)
+	for _, record := range existing {
+		if strings.ToUpper(record.Type) == "CNAME" {
+			existingCNAMEs[strings.TrimSuffix(strings.ToLower(record.Name), ".")] = true
+		}
+	}
+	for i, record := range newRecords {
+		name := strings.TrimSuffix(strings.ToLower(record.Name), ".")
+		if strings.ToUpper(record.Type) == "CNAME" || !existingCNAMEs[name] {
+			continue
+		}
+		issues = append(issues, LintIssue{
+			Rule:        RuleCNAMEConflict,
+			Severity:    LintError,
+			Zone:        strings.TrimSuffix(strings.ToLower(zone), "."),
+			RecordIndex: i,
+			Record:      record,
+			Message:     fmt.Sprintf("%s 已存在 CNAME 记录，不能再添加 %s 记录", name, strings.ToUpper(record.Type)),
+		})
+	}
+	return issues
+}
+
+// lintAddress 检查 A/AAAA 记录的地址
+func lintAddress(idx int, recordType, content string, add func(idx int, rule, severity, format string, args ...interface{})) {
+	addr, err := netip.ParseAddr(strings.TrimSpace(content))
+	if err != nil || addr.Zone() != "" {
+		add(idx, RuleInvalidIP, LintError, "无效的 IP 地址: %s", content)
+		return
+	}
+
+	if recordType == "A" && !addr.Is4() {
+		add(idx, RuleInvalidIP, LintError, "A 记录需要 IPv4 地址: %s", content)
+		return
+	}
+	if recordType == "AAAA" && (!addr.Is6() || addr.Is4In6()) {
+		add(idx, RuleInvalidIP, LintError, "AAAA 记录需要 IPv6 地址: %s", content)
+		return
+	}
+
+	if addr.IsPrivate() || addr.IsLoopback() || addr.IsLinkLocalUnicast() || addr.IsUnspecified() || cgnatPrefix.Contains(addr) {
+		add(idx, RulePrivateIP, LintWarning, "公网 DNS 记录指向内网地址: %s", content)
+	}
+}
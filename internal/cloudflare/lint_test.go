@@ -0,0 +1,153 @@
+package cloudflare
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestLintRecords(t *testing.T) {
+	cloudFront := map[string]bool{"d111111abcdef8.cloudfront.net": true}
+
+	tests := []struct {
+		name    string
+		records []DNSRecordInfo
+		opts    LintOptions
+		want    []string
+	}{
+		{
+			name: "没有问题",
+			records: []DNSRecordInfo{
+				{Type: "A", Name: "www.example.com", Content: "203.0.113.1", TTL: 1, Proxied: true},
+				{Type: "AAAA", Name: "www.example.com", Content: "2001:4860::1", TTL: 300},
+				{Type: "CNAME", Name: "cdn.example.com", Content: "d111111abcdef8.cloudfront.net", TTL: 1},
+			},
+			opts: LintOptions{CloudFrontDomains: cloudFront},
+		},
+		{
+			name: "CNAME 与其他记录共存",
+			records: []DNSRecordInfo{
+				{Type: "CNAME", Name: "api.example.com", Content: "lb.example.net", TTL: 1},
+				{Type: "TXT", Name: "API.example.com.", Content: "v=spf1", TTL: 1},
+			},
+			want: []string{RuleCNAMEConflict},
+		},
+		{
+			name: "不支持代理的类型",
+			records: []DNSRecordInfo{
+				{Type: "TXT", Name: "example.com", Content: "hello", TTL: 1, Proxied: true},
+			},
+			want: []string{RuleProxiedUnsupported},
+		},
+		{
+			name: "无效的 IP 地址",
+			records: []DNSRecordInfo{
+				{Type: "A", Name: "a.example.com", Content: "300.1.1.1", TTL: 1},
+				{Type: "A", Name: "b.example.com", Content: "2001:db8::1", TTL: 1},
+				{Type: "AAAA", Name: "c.example.com", Content: "203.0.113.1", TTL: 1},
+				{Type: "AAAA", Name: "d.example.com", Content: "::ffff:203.0.113.1", TTL: 1},
+			},
+			want: []string{RuleInvalidIP, RuleInvalidIP, RuleInvalidIP, RuleInvalidIP},
+		},
+		{
+			name: "内网地址",
+			records: []DNSRecordInfo{
+				{Type: "A", Name: "a.example.com", Content: "10.0.0.1", TTL: 1},
+				{Type: "A", Name: "b.example.com", Content: "127.0.0.1", TTL: 1},
+				{Type: "A", Name: "c.example.com", Content: "100.64.1.1", TTL: 1},
+				{Type: "AAAA", Name: "d.example.com", Content: "fd00::1", TTL: 1},
+			},
+			want: []string{RulePrivateIP, RulePrivateIP, RulePrivateIP, RulePrivateIP},
+		},
+		{
+			name: "启用代理时设置 TTL",
+			records: []DNSRecordInfo{
+				{Type: "A", Name: "www.example.com", Content: "203.0.113.1", TTL: 3600, Proxied: true},
+			},
+			want: []string{RuleTTLProxied},
+		},
+		{
+			name: "根域名 CNAME",
+			records: []DNSRecordInfo{
+				{Type: "CNAME", Name: "example.com", Content: "lb.example.net", TTL: 1},
+			},
+			want: []string{RuleApexCNAME},
+		},
+		{
+			name: "指向已删除的 CloudFront 分发",
+			records: []DNSRecordInfo{
+				{Type: "CNAME", Name: "old.example.com", Content: "d222222abcdef8.cloudfront.net.", TTL: 1},
+			},
+			opts: LintOptions{CloudFrontDomains: cloudFront},
+			want: []string{RuleDanglingCloudFront},
+		},
+		{
+			name: "未提供分发列表时不检查 CloudFront",
+			records: []DNSRecordInfo{
+				{Type: "CNAME", Name: "old.example.com", Content: "d222222abcdef8.cloudfront.net", TTL: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := LintRecords("example.com", tt.records, tt.opts)
+
+			var got []string
+			for _, issue := range issues {
+				got = append(got, issue.Rule)
+			}
+			sort.Strings(got)
+			want := append([]string(nil), tt.want...)
+			sort.Strings(want)
+
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("LintRecords() rules = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestLintNewRecords(t *testing.T) {
+	existing := []DNSRecordInfo{
+		{Type: "A", Name: "www.example.com", Content: "203.0.113.1", TTL: 1},
+		// 现有记录的问题不应报告
+		{Type: "A", Name: "internal.example.com", Content: "10.0.0.1", TTL: 1},
+	}
+	newRecords := RecordsFromConfig("example.com", []DNSRecordConfig{
+		{Type: "A", Name: "api", Content: "203.0.113.2"},
+		{Type: "cname", Name: "www", Content: "lb.example.net"},
+	})
+
+	issues := LintNewRecords("example.com", existing, newRecords, LintOptions{})
+	if len(issues) != 1 {
+		t.Fatalf("LintNewRecords() = %v, want 1 issue", issues)
+	}
+	if issues[0].Rule != RuleCNAMEConflict || issues[0].RecordIndex != 1 {
+		t.Errorf("LintNewRecords() = %+v, want cname-conflict at index 1", issues[0])
+	}
+	if !HasLintErrors(issues) {
+		t.Error("HasLintErrors() = false, want true")
+	}
+}
+
+func TestLintNewRecordsExistingCNAME(t *testing.T) {
+	existing := []DNSRecordInfo{
+		{Type: "CNAME", Name: "www.example.com", Content: "lb.example.net", TTL: 1},
+	}
+	newRecords := RecordsFromConfig("example.com", []DNSRecordConfig{
+		{Type: "A", Name: "api", Content: "203.0.113.2"},
+		{Type: "A", Name: "WWW", Content: "203.0.113.1"},
+	})
+
+	issues := LintNewRecords("example.com", existing, newRecords, LintOptions{})
+	if len(issues) != 1 {
+		t.Fatalf("LintNewRecords() = %v, want 1 issue", issues)
+	}
+	if issues[0].Rule != RuleCNAMEConflict || issues[0].RecordIndex != 1 {
+		t.Errorf("LintNewRecords() = %+v, want cname-conflict at index 1", issues[0])
+	}
+	if !HasLintErrors(issues) {
+		t.Error("HasLintErrors() = false, want true")
+	}
+}
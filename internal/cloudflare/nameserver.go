@@ -0,0 +1,81 @@
+package cloudflare
+
+import (
+	"sort"
+	"strings"
+)
+
+// Name Server 委派状态
+const (
+	// DelegationCorrect 委派的 Name Server 与 Cloudflare 分配的完全一致
+	DelegationCorrect = "correct"
+	// DelegationPartial 部分委派到 Cloudflare 分配的 Name Server
+	DelegationPartial = "partial"
+	// DelegationIncorrect 没有委派到 Cloudflare 分配的 Name Server
+	DelegationIncorrect = "incorrect"
+)
+
+// NameServerCheck Name Server 委派检查结果
+type NameServerCheck struct {
+	Status string
+	// Expected Cloudflare 分配的 Name Server
+	Expected []string
+	// Actual 实际查询到的 Name Server
+	Actual []string
+	// Missing 未委派的 Cloudflare Name Server
+	Missing []string
+	// Extra 多余的非 Cloudflare 分配的 Name Server
+	Extra []string
+}
+
+// CompareNameServers 比较 Cloudflare 分配的 Name Server 与实际委派的 Name Server
+func CompareNameServers(expected, actual []string) NameServerCheck {
+	check := NameServerCheck{
+		Expected: normalizeNameServers(expected),
+		Actual:   normalizeNameServers(actual),
+	}
+
+	actualSet := make(map[string]bool, len(check.Actual))
+	for _, ns := range check.Actual {
+		actualSet[ns] = true
+	}
+	expectedSet := make(map[string]bool, len(check.Expected))
+	for _, ns := range check.Expected {
+		expectedSet[ns] = true
+		if !actualSet[ns] {
+			check.Missing = append(check.Missing, ns)
+		}
+	}
+	for _, ns := range check.Actual {
+		if !expectedSet[ns] {
+			check.Extra = append(check.Extra, ns)
+		}
+	}
+
+	switch {
+	case len(check.Expected) > 0 && len(check.Missing) == 0 && len(check.Extra) == 0:
+		check.Status = DelegationCorrect
+	case len(check.Missing) < len(check.Expected):
+		check.Status = DelegationPartial
+	default:
+		check.Status = DelegationIncorrect
+	}
+
+	return check
+}
+
+// normalizeNameServers 规范化 Name Server 列表：小写、去掉末尾的点、去重并排序
+func normalizeNameServers(servers []string) []string {
+	seen := make(map[string]bool, len(servers))
+	result := make([]string, 0, len(servers))
+	for _, ns := range servers {
+		ns = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(ns)), ".")
+		if ns == "" || seen[ns] {
+			continue
+		}
+		seen[ns] = true
+		result = append(result, ns)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,78 @@
+package cloudflare
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCompareNameServers(t *testing.T) {
+	cfNS := []string{"ada.ns.cloudflare.com", "bob.ns.cloudflare.com"}
+
+	tests := []struct {
+		name        string
+		expected    []string
+		actual      []string
+		wantStatus  string
+		wantMissing []string
+		wantExtra   []string
+	}{
+		{
+			name:       "完全一致",
+			expected:   cfNS,
+			actual:     []string{"BOB.ns.cloudflare.com.", "ada.ns.cloudflare.com."},
+			wantStatus: DelegationCorrect,
+		},
+		{
+			name:        "只委派了一个",
+			expected:    cfNS,
+			actual:      []string{"ada.ns.cloudflare.com"},
+			wantStatus:  DelegationPartial,
+			wantMissing: []string{"bob.ns.cloudflare.com"},
+		},
+		{
+			name:       "混合旧的 Name Server",
+			expected:   cfNS,
+			actual:     []string{"ada.ns.cloudflare.com", "bob.ns.cloudflare.com", "ns1.registrar.com"},
+			wantStatus: DelegationPartial,
+			wantExtra:  []string{"ns1.registrar.com"},
+		},
+		{
+			name:        "仍是旧的 Name Server",
+			expected:    cfNS,
+			actual:      []string{"ns1.registrar.com", "ns2.registrar.com"},
+			wantStatus:  DelegationIncorrect,
+			wantMissing: cfNS,
+			wantExtra:   []string{"ns1.registrar.com", "ns2.registrar.com"},
+		},
+		{
+			name:        "未查询到 Name Server",
+			expected:    cfNS,
+			actual:      nil,
+			wantStatus:  DelegationIncorrect,
+			wantMissing: cfNS,
+		},
+		{
+			name:       "Zone 没有分配 Name Server",
+			expected:   nil,
+			actual:     []string{"ns1.registrar.com"},
+			wantStatus: DelegationIncorrect,
+			wantExtra:  []string{"ns1.registrar.com"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CompareNameServers(tt.expected, tt.actual)
+
+			if got.Status != tt.wantStatus {
+				t.Errorf("Status = %q, want %q", got.Status, tt.wantStatus)
+			}
+			if !reflect.DeepEqual(got.Missing, tt.wantMissing) {
+				t.Errorf("Missing = %v, want %v", got.Missing, tt.wantMissing)
+			}
+			if !reflect.DeepEqual(got.Extra, tt.wantExtra) {
+				t.Errorf("Extra = %v, want %v", got.Extra, tt.wantExtra)
+			}
+		})
+	}
+}
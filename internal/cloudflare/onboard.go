@@ -0,0 +1,263 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// OnboardConfig 批量接入域名配置
+type OnboardConfig struct {
+	// Baseline 设置基线文件路径，相对路径基于配置文件所在目录
+	Baseline string `yaml:"baseline,omitempty"`
+	// Settings 内联的设置基线，与基线文件中的同名设置冲突时以此为准
+	Settings map[string]string `yaml:"settings,omitempty"`
+	// OwnerTag 所有权标签，导入的初始记录会自动添加该标签，与批量操作的 owner_tag 相同
+	OwnerTag string              `yaml:"owner_tag,omitempty"`
+	Zones    []OnboardZoneConfig `yaml:"zones"`
+}
+
+// OnboardZoneConfig 单个域名的接入配置
+type OnboardZoneConfig struct {
+	Name string `yaml:"name"`
+	// Records 接入时导入的初始 DNS 记录
+	Records []DNSRecordConfig `yaml:"records,omitempty"`
+}
+
+// OnboardResult 单个域名的接入结果
+type OnboardResult struct {
+	Zone   string
+	ZoneID string
+	Status string
+	// Created 是否为本次新建的域名，已存在的域名为 false
+	Created     bool
+	NameServers []string
+	// RecordsCreated 成功导入的记录数
+	RecordsCreated int
+	// RecordsFailed 导入失败的记录数
+	RecordsFailed int
+	// SettingsChanged 修改的设置项数
+	SettingsChanged int
+	Error           error
+}
+
+// Success 检查接入是否全部成功
+func (r *OnboardResult) Success() bool {
+	return r.Error == nil && r.RecordsFailed == 0
+}
+
+// LoadOnboardConfig 从 YAML 文件加载接入配置，并合并设置基线
+func LoadOnboardConfig(filename string) (*OnboardConfig, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("读取配置文件失败: %w", err)
+	}
+
+	var config OnboardConfig
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("解析配置文件失败: %w", err)
+	}
+
+	if config.Baseline != "" {
+		path := config.Baseline
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(filepath.Dir(filename), path)
+		}
+
+		baseline, err := LoadSettingsBaseline(path)
+		if err != nil {
+			return nil, fmt.Errorf("加载设置基线失败: %w", err)
+		}
+
+		merged := baseline.Settings
+		for id, value := range config.Settings {
+			merged[id] = value
+		}
+		config.Settings = merged
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("配置验证失败: %w", err)
+	}
+
+	return &config, nil
+}
+
+// Validate 验证接入配置
+func (c *OnboardConfig) Validate() error {
+	if len(c.Zones) == 0 {
+		return fmt.Errorf("至少需要配置一个 zone")
+	}
+
+	for id, value := range c.Settings {
+		if err := ValidateSetting(id, value); err != nil {
+			return err
+		}
+	}
+
+	if c.OwnerTag != "" {
+		if err := ValidateTag(c.OwnerTag); err != nil {
+			return fmt.Errorf("owner_tag: %w", err)
+		}
+	}
+
+	seen := make(map[string]bool, len(c.Zones))
+	for i, zone := range c.Zones {
+		name := strings.ToLower(strings.TrimSpace(zone.Name))
+		if name == "" {
+			return fmt.Errorf("zone[%d]: name 不能为空", i)
+		}
+		if seen[name] {
+			return fmt.Errorf("zone[%d]: 域名 %s 重复", i, name)
+		}
+		seen[name] = true
+
+		// 记录的校验规则与批量创建一致
+		if len(zone.Records) > 0 {
+			batch := DNSBatchConfig{Zones: []DNSZoneConfig{{Zone: name, Records: zone.Records}}}
+			if err := batch.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// OnboardZones 并发接入多个域名：创建 Zone（已存在时直接使用）、导入初始记录并应用设置基线
+// 结果顺序与配置一致
+func (c *Client) OnboardZones(ctx context.Context, config *OnboardConfig, maxConcurrency int, progressCallback func(string)) ([]OnboardResult, error) {
+	if maxConcurrency < 1 {
+		maxConcurrency = 1
+	}
+
+	c.logger.Info("开始批量接入域名", "zones", len(config.Zones), "max_concurrency", maxConcurrency)
+
+	// 一次性获取已有的 Zone，避免每个域名都列出一遍
+	existingZones, err := c.ListZones(ctx)
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[string]ZoneInfo, len(existingZones))
+	for _, zone := range existingZones {
+		existing[strings.ToLower(zone.Name)] = zone
+	}
+
+	results := make([]OnboardResult, len(config.Zones))
+
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, maxConcurrency)
+
+	for i, zoneConfig := range config.Zones {
+		wg.Add(1)
+		go func(idx int, cfg OnboardZoneConfig) {
+			defer wg.Done()
+
+			// 获取信号量
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			name := strings.ToLower(strings.TrimSpace(cfg.Name))
+			var zone *ZoneInfo
+			if z, ok := existing[name]; ok {
+				zone = &z
+			}
+
+			results[idx] = c.onboardZone(ctx, name, zone, cfg.Records, config.Settings, config.OwnerTag, progressCallback)
+		}(i, zoneConfig)
+	}
+
+	wg.Wait()
+
+	return results, nil
+}
+
+// onboardZone 接入单个域名，zone 为 nil 时创建新的 Zone，导入的记录添加所有权标签 ownerTag
+func (c *Client) onboardZone(ctx context.Context, name string, zone *ZoneInfo, records []DNSRecordConfig, settings map[string]string, ownerTag string, progressCallback func(string)) OnboardResult {
+	result := OnboardResult{Zone: name}
+
+	progress := func(format string, args ...interface{}) {
+		if progressCallback != nil {
+			progressCallback(fmt.Sprintf("%s: %s", name, fmt.Sprintf(format, args...)))
+		}
+	}
+
+	if zone == nil {
+		progress("创建域名")
+		created, err := c.CreateZone(ctx, name)
+		if err != nil {
+			result.Error = err
+			return result
+		}
+		zone = created
+		result.Created = true
+	} else {
+		progress("域名已存在，跳过创建")
+	}
+
+	result.ZoneID = zone.ID
+	result.Status = zone.Status
+	result.NameServers = zone.NameServers
+	if len(zone.VanityNameServers) > 0 {
+		result.NameServers = zone.VanityNameServers
+	}
+
+	// 重复执行接入时已存在的相同记录视为成功；配置了所有权标签时为已存在的相同记录添加标签，
+	// 并更新带有该标签的记录
+	policy := ConflictSkip
+	if ownerTag != "" {
+		policy = ConflictUpdate
+	}
+
+	// 已存在的域名先获取现有记录，用于匹配同名同类型的记录
+	var existing []DNSRecordInfo
+	if !result.Created && len(records) > 0 {
+		var err error
+		existing, err = c.ListDNSRecords(ctx, zone.ID, "")
+		if err != nil {
+			result.Error = fmt.Errorf("获取 DNS 记录失败: %w", err)
+			return result
+		}
+	}
+	matcher := newExistingRecordMatcher(zone, existing, records)
+
+	for i, record := range records {
+		progress("导入记录 %d/%d (%s %s)", i+1, len(records), record.Type, record.Name)
+		if r := c.processCreateRecord(ctx, zone, matcher, i, record, policy, ownerTag); r.Success {
+			result.RecordsCreated++
+		} else {
+			result.RecordsFailed++
+		}
+	}
+
+	if len(settings) > 0 {
+		progress("应用设置基线")
+		changes, err := c.PlanZoneSettings(ctx, zone.ID, settings)
+		if err != nil {
+			result.Error = fmt.Errorf("获取设置失败: %w", err)
+			return result
+		}
+
+		applied, err := c.ApplySettingChanges(ctx, zone.ID, changes)
+		result.SettingsChanged = applied
+		if err != nil {
+			result.Error = fmt.Errorf("应用设置失败: %w", err)
+			return result
+		}
+	}
+
+	c.logger.Info("域名接入完成",
+		"zone", name,
+		"created", result.Created,
+		"records_created", result.RecordsCreated,
+		"records_failed", result.RecordsFailed,
+		"settings_changed", result.SettingsChanged,
+	)
+
+	return result
+}
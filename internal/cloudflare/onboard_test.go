@@ -0,0 +1,137 @@
+package cloudflare
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLoadOnboardConfig(t *testing.T) {
+	dir := t.TempDir()
+	baseline := `settings:
+  ssl: full
+  always_use_https: "on"
+`
+	if err := os.WriteFile(filepath.Join(dir, "baseline.yaml"), []byte(baseline), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		content      string
+		wantSettings map[string]string
+		wantOwnerTag string
+		wantZones    int
+		wantErr      bool
+	}{
+		{
+			name: "基线文件与内联设置合并",
+			content: `baseline: baseline.yaml
+settings:
+  ssl: strict
+zones:
+  - name: example.com
+    records:
+      - type: A
+        name: www
+        content: 192.0.2.1
+  - name: test.com
+`,
+			wantSettings: map[string]string{"ssl": "strict", "always_use_https": "on"},
+			wantZones:    2,
+		},
+		{
+			name: "只有域名",
+			content: `zones:
+  - name: example.com
+`,
+			wantZones: 1,
+		},
+		{
+			name:    "没有域名",
+			content: "zones: []\n",
+			wantErr: true,
+		},
+		{
+			name: "域名重复",
+			content: `zones:
+  - name: example.com
+  - name: Example.com
+`,
+			wantErr: true,
+		},
+		{
+			name: "记录无效",
+			content: `zones:
+  - name: example.com
+    records:
+      - type: MX
+        name: "@"
+        content: mail.example.com
+`,
+			wantErr: true,
+		},
+		{
+			name: "所有权标签",
+			content: `owner_tag: managed-by:cloudctl
+zones:
+  - name: example.com
+`,
+			wantOwnerTag: "managed-by:cloudctl",
+			wantZones:    1,
+		},
+		{
+			name: "所有权标签无效",
+			content: `owner_tag: ":cloudctl"
+zones:
+  - name: example.com
+`,
+			wantErr: true,
+		},
+		{
+			name: "设置无效",
+			content: `settings:
+  ssl: maximum
+zones:
+  - name: example.com
+`,
+			wantErr: true,
+		},
+		{
+			name: "基线文件不存在",
+			content: `baseline: missing.yaml
+zones:
+  - name: example.com
+`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, "zones.yaml")
+			if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			config, err := LoadOnboardConfig(path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadOnboardConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if len(config.Zones) != tt.wantZones {
+				t.Errorf("Zones = %d, want %d", len(config.Zones), tt.wantZones)
+			}
+			if len(tt.wantSettings) > 0 && !reflect.DeepEqual(config.Settings, tt.wantSettings) {
+				t.Errorf("Settings = %v, want %v", config.Settings, tt.wantSettings)
+			}
+			if config.OwnerTag != tt.wantOwnerTag {
+				t.Errorf("OwnerTag = %q, want %q", config.OwnerTag, tt.wantOwnerTag)
+			}
+		})
+	}
+}
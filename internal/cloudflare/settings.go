@@ -0,0 +1,207 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go/v2"
+	"github.com/cloudflare/cloudflare-go/v2/zones"
+	"gopkg.in/yaml.v3"
+)
+
+// SupportedSettings 支持管理的 Zone 设置及其可选值
+var SupportedSettings = map[string][]string{
+	"ssl":                      {"off", "flexible", "full", "strict"},
+	"always_use_https":         {"on", "off"},
+	"min_tls_version":          {"1.0", "1.1", "1.2", "1.3"},
+	"tls_1_3":                  {"on", "off", "zrt"},
+	"http3":                    {"on", "off"},
+	"brotli":                   {"on", "off"},
+	"automatic_https_rewrites": {"on", "off"},
+	"cache_level":              {"basic", "simplified", "aggressive"},
+}
+
+// SettingsBaseline Zone 设置基线配置
+type SettingsBaseline struct {
+	Settings map[string]string `yaml:"settings"`
+}
+
+// SettingChange 单个设置的变更
+type SettingChange struct {
+	ID      string
+	Current string
+	Desired string
+}
+
+// SupportedSettingIDs 返回所有支持的设置 ID（已排序）
+func SupportedSettingIDs() []string {
+	ids := make([]string, 0, len(SupportedSettings))
+	for id := range SupportedSettings {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// ValidateSetting 验证设置 ID 和值
+func ValidateSetting(id, value string) error {
+	values, ok := SupportedSettings[id]
+	if !ok {
+		return fmt.Errorf("不支持的设置: %s (支持: %s)", id, strings.Join(SupportedSettingIDs(), ", "))
+	}
+	if !slices.Contains(values, value) {
+		return fmt.Errorf("设置 %s 的值无效: %s (可选值: %s)", id, value, strings.Join(values, ", "))
+	}
+	return nil
+}
+
+// LoadSettingsBaseline 从 YAML 文件加载设置基线
+func LoadSettingsBaseline(filename string) (*SettingsBaseline, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("读取配置文件失败: %w", err)
+	}
+
+	var baseline SettingsBaseline
+	if err := yaml.Unmarshal(data, &baseline); err != nil {
+		return nil, fmt.Errorf("解析配置文件失败: %w", err)
+	}
+
+	if err := baseline.Validate(); err != nil {
+		return nil, fmt.Errorf("配置验证失败: %w", err)
+	}
+
+	return &baseline, nil
+}
+
+// Validate 验证设置基线
+func (b *SettingsBaseline) Validate() error {
+	if len(b.Settings) == 0 {
+		return fmt.Errorf("至少需要配置一项设置")
+	}
+
+	for id, value := range b.Settings {
+		if err := ValidateSetting(id, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetZoneSettings 获取 Zone 的设置，ids 为空时获取所有支持的设置
+func (c *Client) GetZoneSettings(ctx context.Context, zoneID string, ids []string) (map[string]string, error) {
+	if len(ids) == 0 {
+		ids = SupportedSettingIDs()
+	}
+
+	c.logger.Debug("获取 Zone 设置", "zone_id", zoneID, "settings", ids)
+
+	settings := make(map[string]string, len(ids))
+	for _, id := range ids {
+		var value string
+		err := c.WithRetry(ctx, "获取 Zone 设置", func() error {
+			result, err := c.api.Zones.Settings.Get(ctx, id, zones.SettingGetParams{
+				ZoneID: cloudflare.F(zoneID),
+			})
+			if err != nil {
+				return fmt.Errorf("获取设置 %s 失败: %w", id, err)
+			}
+			value = settingValue(result.JSON.Value.Raw())
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		settings[id] = value
+	}
+
+	return settings, nil
+}
+
+// UpdateZoneSetting 修改 Zone 的单个设置
+func (c *Client) UpdateZoneSetting(ctx context.Context, zoneID, id, value string) error {
+	if err := ValidateSetting(id, value); err != nil {
+		return NewValidationError("修改 Zone 设置", err.Error())
+	}
+
+	c.logger.Info("修改 Zone 设置", "zone_id", zoneID, "setting", id, "value", value)
+
+	return c.WithRetry(ctx, "修改 Zone 设置", func() error {
+		_, err := c.api.Zones.Settings.Edit(ctx, id, zones.SettingEditParams{
+			ZoneID: cloudflare.F(zoneID),
+			Body: zones.SettingEditParamsBody{
+				Value: cloudflare.F[interface{}](value),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("修改设置 %s 失败: %w", id, err)
+		}
+		return nil
+	})
+}
+
+// PlanZoneSettings 获取 Zone 当前设置并计算与期望设置的差异
+func (c *Client) PlanZoneSettings(ctx context.Context, zoneID string, desired map[string]string) ([]SettingChange, error) {
+	ids := make([]string, 0, len(desired))
+	for id := range desired {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	current, err := c.GetZoneSettings(ctx, zoneID, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	return DiffSettings(current, desired), nil
+}
+
+// ApplySettingChanges 依次应用设置变更，遇到错误时停止并返回已应用的数量
+func (c *Client) ApplySettingChanges(ctx context.Context, zoneID string, changes []SettingChange) (int, error) {
+	for i, change := range changes {
+		if err := c.UpdateZoneSetting(ctx, zoneID, change.ID, change.Desired); err != nil {
+			return i, err
+		}
+	}
+	return len(changes), nil
+}
+
+// DiffSettings 计算当前设置与期望设置的差异，结果按设置 ID 排序
+func DiffSettings(current, desired map[string]string) []SettingChange {
+	var changes []SettingChange
+	for id, want := range desired {
+		if current[id] == want {
+			continue
+		}
+		changes = append(changes, SettingChange{
+			ID:      id,
+			Current: current[id],
+			Desired: want,
+		})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].ID < changes[j].ID
+	})
+
+	return changes
+}
+
+// settingValue 将设置值的原始 JSON 转换为字符串
+func settingValue(raw string) string {
+	if raw == "" {
+		return ""
+	}
+
+	var s string
+	if err := json.Unmarshal([]byte(raw), &s); err == nil {
+		return s
+	}
+	return raw
+}
@@ -0,0 +1,127 @@
+package cloudflare
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestValidateSetting(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		value   string
+		wantErr bool
+	}{
+		{"SSL 严格模式", "ssl", "strict", false},
+		{"最低 TLS 版本", "min_tls_version", "1.2", false},
+		{"缓存级别", "cache_level", "aggressive", false},
+		{"不支持的设置", "rocket_loader", "on", true},
+		{"无效的值", "always_use_https", "yes", true},
+		{"值区分大小写", "ssl", "Strict", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSetting(tt.id, tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateSetting() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDiffSettings(t *testing.T) {
+	current := map[string]string{
+		"ssl":              "flexible",
+		"always_use_https": "on",
+		"min_tls_version":  "1.0",
+	}
+	desired := map[string]string{
+		"ssl":              "strict",
+		"always_use_https": "on",
+		"min_tls_version":  "1.2",
+		"http3":            "on",
+	}
+
+	want := []SettingChange{
+		{ID: "http3", Current: "", Desired: "on"},
+		{ID: "min_tls_version", Current: "1.0", Desired: "1.2"},
+		{ID: "ssl", Current: "flexible", Desired: "strict"},
+	}
+
+	if got := DiffSettings(current, desired); !reflect.DeepEqual(got, want) {
+		t.Errorf("DiffSettings() = %v, want %v", got, want)
+	}
+
+	if got := DiffSettings(desired, desired); len(got) != 0 {
+		t.Errorf("DiffSettings() 相同设置应无差异, got %v", got)
+	}
+}
+
+func TestLoadSettingsBaseline(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "有效配置",
+			content: `settings:
+  ssl: strict
+  always_use_https: on
+  min_tls_version: 1.2
+`,
+			want: map[string]string{"ssl": "strict", "always_use_https": "on", "min_tls_version": "1.2"},
+		},
+		{
+			name:    "空配置",
+			content: "settings: {}\n",
+			wantErr: true,
+		},
+		{
+			name: "无效的值",
+			content: `settings:
+  ssl: full_strict
+`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "baseline.yaml")
+			if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			baseline, err := LoadSettingsBaseline(path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadSettingsBaseline() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(baseline.Settings, tt.want) {
+				t.Errorf("Settings = %v, want %v", baseline.Settings, tt.want)
+			}
+		})
+	}
+}
+
+func TestSettingValue(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want string
+	}{
+		{`"on"`, "on"},
+		{`"1.2"`, "1.2"},
+		{`14400`, "14400"},
+		{``, ""},
+	}
+
+	for _, tt := range tests {
+		if got := settingValue(tt.raw); got != tt.want {
+			t.Errorf("settingValue(%q) = %q, want %q", tt.raw, got, tt.want)
+		}
+	}
+}
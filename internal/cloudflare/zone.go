@@ -2,24 +2,39 @@ package cloudflare
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/cloudflare/cloudflare-go/v2"
+	"github.com/cloudflare/cloudflare-go/v2/option"
 	"github.com/cloudflare/cloudflare-go/v2/zones"
 )
 
 // ZoneInfo Zone 信息
 type ZoneInfo struct {
-	ID          string    `json:"id"`
-	Name        string    `json:"name"`
-	Status      string    `json:"status"`
-	NameServers []string  `json:"name_servers,omitempty"`
-	CreatedOn   time.Time `json:"created_on"`
-	ModifiedOn  time.Time `json:"modified_on"`
-	ActivatedOn time.Time `json:"activated_on,omitempty"`
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Status      string   `json:"status"`
+	Paused      bool     `json:"paused"`
+	Type        string   `json:"type,omitempty"`
+	Plan        string   `json:"plan,omitempty"`
+	NameServers []string `json:"name_servers,omitempty"`
+	// VanityNameServers 自定义 Name Server（Business 及以上套餐）
+	VanityNameServers []string `json:"vanity_name_servers,omitempty"`
+	// OriginalNameServers 迁移到 Cloudflare 前的 Name Server
+	OriginalNameServers []string `json:"original_name_servers,omitempty"`
+	// OriginalRegistrar 迁移到 Cloudflare 前的注册商
+	OriginalRegistrar string    `json:"original_registrar,omitempty"`
+	CreatedOn         time.Time `json:"created_on"`
+	ModifiedOn        time.Time `json:"modified_on"`
+	ActivatedOn       time.Time `json:"activated_on,omitempty"`
 }
 
+// ZoneStatuses Cloudflare Zone 的所有状态
+var ZoneStatuses = []string{"initializing", "pending", "active", "moved", "deleted", "deactivated"}
+
 // ListZones 列出所有 Zone
 func (c *Client) ListZones(ctx context.Context) ([]ZoneInfo, error) {
 	var allZones []ZoneInfo
@@ -49,27 +64,7 @@ func (c *Client) ListZones(ctx context.Context) ([]ZoneInfo, error) {
 
 			// 转换为我们的数据结构
 			for _, zone := range result.Result {
-				// 获取状态 - 根据 ActivatedOn 判断
-				status := "pending"
-				if !zone.ActivatedOn.IsZero() {
-					status = "active"
-				}
-
-				zoneInfo := ZoneInfo{
-					ID:          zone.ID,
-					Name:        zone.Name,
-					Status:      status,
-					CreatedOn:   zone.CreatedOn,
-					ModifiedOn:  zone.ModifiedOn,
-					ActivatedOn: zone.ActivatedOn,
-				}
-
-				// 添加 Name Servers
-				if len(zone.NameServers) > 0 {
-					zoneInfo.NameServers = zone.NameServers
-				}
-
-				allZones = append(allZones, zoneInfo)
+				allZones = append(allZones, convertZone(&zone))
 			}
 
 			c.logger.Debug("获取到 Zone",
@@ -111,24 +106,8 @@ func (c *Client) GetZone(ctx context.Context, zoneID string) (*ZoneInfo, error)
 			return fmt.Errorf("获取 Zone 失败: %w", err)
 		}
 
-		// 获取状态 - 根据 ActivatedOn 判断
-		status := "pending"
-		if !result.ActivatedOn.IsZero() {
-			status = "active"
-		}
-
-		zoneInfo = &ZoneInfo{
-			ID:          result.ID,
-			Name:        result.Name,
-			Status:      status,
-			CreatedOn:   result.CreatedOn,
-			ModifiedOn:  result.ModifiedOn,
-			ActivatedOn: result.ActivatedOn,
-		}
-
-		if len(result.NameServers) > 0 {
-			zoneInfo.NameServers = result.NameServers
-		}
+		info := convertZone(result)
+		zoneInfo = &info
 
 		return nil
 	})
@@ -207,24 +186,8 @@ func (c *Client) CreateZone(ctx context.Context, name string) (*ZoneInfo, error)
 			return fmt.Errorf("创建 Zone 失败: %w", err)
 		}
 
-		// 获取状态 - 根据 ActivatedOn 判断
-		status := "pending"
-		if !result.ActivatedOn.IsZero() {
-			status = "active"
-		}
-
-		zoneInfo = &ZoneInfo{
-			ID:          result.ID,
-			Name:        result.Name,
-			Status:      status,
-			CreatedOn:   result.CreatedOn,
-			ModifiedOn:  result.ModifiedOn,
-			ActivatedOn: result.ActivatedOn,
-		}
-
-		if len(result.NameServers) > 0 {
-			zoneInfo.NameServers = result.NameServers
-		}
+		info := convertZone(result)
+		zoneInfo = &info
 
 		return nil
 	})
@@ -241,3 +204,134 @@ func (c *Client) CreateZone(ctx context.Context, name string) (*ZoneInfo, error)
 
 	return zoneInfo, nil
 }
+
+// DeleteZone 删除 Zone
+func (c *Client) DeleteZone(ctx context.Context, zoneID string) error {
+	c.logger.Info("删除 Zone", "zone_id", zoneID)
+
+	err := c.WithRetry(ctx, "删除 Zone", func() error {
+		_, err := c.api.Zones.Delete(ctx, zones.ZoneDeleteParams{
+			ZoneID: cloudflare.F(zoneID),
+		})
+		if err != nil {
+			return fmt.Errorf("删除 Zone 失败: %w", err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	c.logger.Info("成功删除 Zone", "zone_id", zoneID)
+	return nil
+}
+
+// SetZonePaused 暂停或恢复 Zone 的 Cloudflare 代理
+// 暂停后所有流量直接回源，DNS 仍由 Cloudflare 解析
+func (c *Client) SetZonePaused(ctx context.Context, zoneID string, paused bool) (*ZoneInfo, error) {
+	c.logger.Info("设置 Zone 暂停状态", "zone_id", zoneID, "paused", paused)
+
+	err := c.WithRetry(ctx, "设置 Zone 暂停状态", func() error {
+		// SDK 的编辑参数不包含 paused 字段，直接写入请求体
+		_, err := c.api.Zones.Edit(ctx, zones.ZoneEditParams{
+			ZoneID: cloudflare.F(zoneID),
+		}, option.WithJSONSet("paused", paused))
+		if err != nil {
+			return fmt.Errorf("设置 Zone 暂停状态失败: %w", err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetZone(ctx, zoneID)
+}
+
+// TriggerActivationCheck 触发 Zone 的 Name Server 激活检查
+func (c *Client) TriggerActivationCheck(ctx context.Context, zoneID string) error {
+	c.logger.Info("触发激活检查", "zone_id", zoneID)
+
+	err := c.WithRetry(ctx, "触发激活检查", func() error {
+		_, err := c.api.Zones.ActivationCheck.Trigger(ctx, zones.ActivationCheckTriggerParams{
+			ZoneID: cloudflare.F(zoneID),
+		})
+		if err != nil {
+			return fmt.Errorf("触发激活检查失败: %w", err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	c.logger.Info("成功触发激活检查", "zone_id", zoneID)
+	return nil
+}
+
+// convertZone 转换 SDK 的 Zone 为 ZoneInfo
+// SDK 结构体缺少状态、暂停状态、类型和套餐字段，从原始 JSON 的额外字段中读取
+func convertZone(zone *zones.Zone) ZoneInfo {
+	info := ZoneInfo{
+		ID:                  zone.ID,
+		Name:                zone.Name,
+		NameServers:         zone.NameServers,
+		VanityNameServers:   zone.VanityNameServers,
+		OriginalNameServers: zone.OriginalNameServers,
+		OriginalRegistrar:   zone.OriginalRegistrar,
+		CreatedOn:           zone.CreatedOn,
+		ModifiedOn:          zone.ModifiedOn,
+		ActivatedOn:         zone.ActivatedOn,
+	}
+
+	extra := zone.JSON.ExtraFields
+
+	if raw := extra["status"].Raw(); raw != "" {
+		json.Unmarshal([]byte(raw), &info.Status)
+	}
+	// 响应中没有状态字段时根据激活时间推断
+	if info.Status == "" {
+		info.Status = "pending"
+		if !zone.ActivatedOn.IsZero() {
+			info.Status = "active"
+		}
+	}
+
+	if raw := extra["paused"].Raw(); raw != "" {
+		json.Unmarshal([]byte(raw), &info.Paused)
+	}
+	if raw := extra["type"].Raw(); raw != "" {
+		json.Unmarshal([]byte(raw), &info.Type)
+	}
+	if raw := extra["plan"].Raw(); raw != "" {
+		var plan struct {
+			Name string `json:"name"`
+		}
+		if json.Unmarshal([]byte(raw), &plan) == nil {
+			info.Plan = plan.Name
+		}
+	}
+
+	return info
+}
+
+// FilterZonesByStatus 按状态过滤 Zone，statuses 为空时返回全部
+func FilterZonesByStatus(zoneList []ZoneInfo, statuses []string) []ZoneInfo {
+	if len(statuses) == 0 {
+		return zoneList
+	}
+
+	var filtered []ZoneInfo
+	for _, zone := range zoneList {
+		for _, status := range statuses {
+			if strings.EqualFold(zone.Status, status) {
+				filtered = append(filtered, zone)
+				break
+			}
+		}
+	}
+	return filtered
+}
@@ -2,10 +2,13 @@ package cloudflare
 
 import (
 	"context"
+	"encoding/json"
 	"log/slog"
 	"os"
 	"testing"
 
+	"github.com/cloudflare/cloudflare-go/v2/zones"
+
 	"github.com/ado1t/cloudctl/internal/config"
 )
 
@@ -198,3 +201,151 @@ func TestZoneInfo_Structure(t *testing.T) {
 		t.Errorf("NameServers length = %d, want 2", len(zone.NameServers))
 	}
 }
+
+func TestConvertZone(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus string
+		wantPaused bool
+		wantType   string
+		wantPlan   string
+	}{
+		{
+			name:       "完整字段",
+			body:       `{"id":"z1","name":"example.com","status":"active","paused":true,"type":"full","plan":{"id":"p1","name":"Free Website"},"activated_on":"2024-01-01T00:00:00Z"}`,
+			wantStatus: "active",
+			wantPaused: true,
+			wantType:   "full",
+			wantPlan:   "Free Website",
+		},
+		{
+			name:       "已激活后迁出",
+			body:       `{"id":"z1","name":"example.com","status":"moved","activated_on":"2024-01-01T00:00:00Z"}`,
+			wantStatus: "moved",
+		},
+		{
+			name:       "缺少状态时根据激活时间推断",
+			body:       `{"id":"z1","name":"example.com","activated_on":"2024-01-01T00:00:00Z"}`,
+			wantStatus: "active",
+		},
+		{
+			name:       "缺少状态且未激活",
+			body:       `{"id":"z1","name":"example.com","activated_on":null}`,
+			wantStatus: "pending",
+		},
+		{
+			name:       "plan 为 null",
+			body:       `{"id":"z1","name":"example.com","status":"pending","type":"partial","plan":null}`,
+			wantStatus: "pending",
+			wantType:   "partial",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var zone zones.Zone
+			if err := json.Unmarshal([]byte(tt.body), &zone); err != nil {
+				t.Fatalf("解析 Zone 失败: %v", err)
+			}
+
+			info := convertZone(&zone)
+
+			if info.ID != "z1" || info.Name != "example.com" {
+				t.Errorf("ID/Name = %q/%q", info.ID, info.Name)
+			}
+			if info.Status != tt.wantStatus {
+				t.Errorf("Status = %q, want %q", info.Status, tt.wantStatus)
+			}
+			if info.Paused != tt.wantPaused {
+				t.Errorf("Paused = %v, want %v", info.Paused, tt.wantPaused)
+			}
+			if info.Type != tt.wantType {
+				t.Errorf("Type = %q, want %q", info.Type, tt.wantType)
+			}
+			if info.Plan != tt.wantPlan {
+				t.Errorf("Plan = %q, want %q", info.Plan, tt.wantPlan)
+			}
+		})
+	}
+}
+
+func TestConvertZoneOriginalNameServers(t *testing.T) {
+	body := `{"id":"z1","name":"example.com","status":"pending",
+		"name_servers":["ada.ns.cloudflare.com","bob.ns.cloudflare.com"],
+		"original_name_servers":["ns1.registrar.com"],
+		"original_registrar":"example registrar",
+		"vanity_name_servers":["ns1.example.com"]}`
+
+	var zone zones.Zone
+	if err := json.Unmarshal([]byte(body), &zone); err != nil {
+		t.Fatalf("解析 Zone 失败: %v", err)
+	}
+
+	info := convertZone(&zone)
+	if len(info.NameServers) != 2 {
+		t.Errorf("NameServers = %v", info.NameServers)
+	}
+	if len(info.OriginalNameServers) != 1 || info.OriginalNameServers[0] != "ns1.registrar.com" {
+		t.Errorf("OriginalNameServers = %v", info.OriginalNameServers)
+	}
+	if info.OriginalRegistrar != "example registrar" {
+		t.Errorf("OriginalRegistrar = %q", info.OriginalRegistrar)
+	}
+	if len(info.VanityNameServers) != 1 {
+		t.Errorf("VanityNameServers = %v", info.VanityNameServers)
+	}
+}
+
+func TestFilterZonesByStatus(t *testing.T) {
+	zoneList := []ZoneInfo{
+		{Name: "a.com", Status: "active"},
+		{Name: "b.com", Status: "pending"},
+		{Name: "c.com", Status: "moved"},
+	}
+
+	tests := []struct {
+		name     string
+		statuses []string
+		want     []string
+	}{
+		{"不过滤", nil, []string{"a.com", "b.com", "c.com"}},
+		{"单个状态", []string{"pending"}, []string{"b.com"}},
+		{"多个状态", []string{"active", "MOVED"}, []string{"a.com", "c.com"}},
+		{"无匹配", []string{"deleted"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FilterZonesByStatus(zoneList, tt.statuses)
+			if len(got) != len(tt.want) {
+				t.Fatalf("FilterZonesByStatus() 返回 %d 个, want %d", len(got), len(tt.want))
+			}
+			for i, zone := range got {
+				if zone.Name != tt.want[i] {
+					t.Errorf("got[%d] = %s, want %s", i, zone.Name, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestProxiedRecords(t *testing.T) {
+	records := []DNSRecordInfo{
+		{ID: "1", Name: "www.example.com", Proxied: true},
+		{ID: "2", Name: "mail.example.com", Proxied: false},
+		{ID: "3", Name: "api.example.com", Proxied: true},
+	}
+
+	proxied := ProxiedRecords(records)
+	if len(proxied) != 2 {
+		t.Fatalf("ProxiedRecords() 返回 %d 条记录, want 2", len(proxied))
+	}
+	if proxied[0].ID != "1" || proxied[1].ID != "3" {
+		t.Errorf("ProxiedRecords() = %v", proxied)
+	}
+
+	if got := ProxiedRecords(nil); len(got) != 0 {
+		t.Errorf("ProxiedRecords(nil) = %v, want empty", got)
+	}
+}
@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ado1t/cloudctl/internal/audit"
+	"github.com/ado1t/cloudctl/internal/cloudflare"
+	"github.com/ado1t/cloudctl/internal/logger"
+	"github.com/ado1t/cloudctl/internal/probe"
+)
+
+var (
+	// Audit dangling 参数
+	auditDanglingZones        []string
+	auditDanglingAWSProfile   string
+	auditDanglingNoCloudFront bool
+	auditDanglingResolver     string
+	auditDanglingTimeout      time.Duration
+	auditDanglingConcurrency  int
+	auditDanglingAll          bool
+)
+
+// auditCmd 表示 audit 命令
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "跨云平台安全审计",
+	Long: `结合 Cloudflare 和云服务商的资源进行安全审计。
+
+可用命令:
+  cloudctl audit dangling  - 检查指向已删除云资源的 CNAME 记录`,
+}
+
+// auditDanglingCmd 检查悬空 CNAME 记录
+var auditDanglingCmd = &cobra.Command{
+	Use:   "dangling",
+	Short: "检查指向已删除云资源的 CNAME 记录",
+	Long: `检查 Cloudflare 中指向已知云服务的 CNAME 记录，找出目标已不存在的记录。
+这类记录可能被他人重新注册同名资源后接管子域名。
+
+检查方式:
+  - CloudFront (*.cloudfront.net): 与 AWS 账号中现有分发的域名比对
+  - S3 (*.s3*.amazonaws.com): 以记录名称为 Host 请求 S3，返回 NoSuchBucket 时存储桶已被删除
+  - ELB、Elastic Beanstalk、Azure 等: 目标域名不存在 (NXDOMAIN)
+
+目标不属于已知云服务的 CNAME 记录不会被检查。
+默认只输出发现问题或无法检查的记录，使用 --all 输出所有检查过的记录。
+发现悬空记录时命令返回非零退出码，可配合 -o json 提供给安全团队或在 CI 中使用。
+
+使用示例:
+  # 检查所有域名
+  cloudctl audit dangling --aws-profile prod
+
+  # 只检查指定域名，输出 JSON
+  cloudctl audit dangling --zones example.com,test.com -o json
+
+  # 没有 AWS 凭证时跳过 CloudFront 比对
+  cloudctl audit dangling --no-cloudfront`,
+	Args: cobra.NoArgs,
+	RunE: runAuditDangling,
+}
+
+func init() {
+	auditCmd.AddCommand(auditDanglingCmd)
+
+	// Audit dangling 命令参数
+	auditDanglingCmd.Flags().StringSliceVar(&auditDanglingZones, "zones", nil, "只检查指定的域名，多个域名用逗号分隔")
+	auditDanglingCmd.Flags().StringVar(&auditDanglingAWSProfile, "aws-profile", "", "获取 CloudFront 分发使用的 AWS profile")
+	auditDanglingCmd.Flags().BoolVar(&auditDanglingNoCloudFront, "no-cloudfront", false, "不获取 CloudFront 分发列表，跳过 CloudFront 目标")
+	auditDanglingCmd.Flags().StringVar(&auditDanglingResolver, "resolver", "", "查询使用的 DNS 服务器，格式: ip 或 ip:port（默认使用系统解析器）")
+	auditDanglingCmd.Flags().DurationVar(&auditDanglingTimeout, "timeout", audit.DefaultTimeout, "单个检查的超时时间")
+	auditDanglingCmd.Flags().IntVar(&auditDanglingConcurrency, "concurrency", audit.DefaultConcurrency, "并发数")
+	auditDanglingCmd.Flags().BoolVar(&auditDanglingAll, "all", false, "输出所有检查过的记录，包括正常的记录")
+}
+
+// runAuditDangling 执行 audit dangling 命令
+func runAuditDangling(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	profile, _ := cmd.Flags().GetString("profile")
+
+	var cloudFront map[string]bool
+	if !auditDanglingNoCloudFront {
+		domains, err := cloudFrontDomains(ctx, auditDanglingAWSProfile)
+		if err != nil {
+			return err
+		}
+		cloudFront = domains
+	}
+
+	// 创建 Cloudflare 客户端
+	logger.Debug("创建 Cloudflare 客户端", "profile", profile)
+	client, err := cloudflare.NewClient(profile, logger.Logger)
+	if err != nil {
+		logger.Error("创建客户端失败", "error", err)
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
+	}
+	defer client.Close()
+
+	logger.Info("正在获取 CNAME 记录...")
+	result, err := client.SearchDNSRecords(ctx, cloudflare.DNSSearchOptions{
+		Name:  "*",
+		Type:  "CNAME",
+		Zones: auditDanglingZones,
+	}, func(msg string) {
+		logger.Debug(msg)
+	})
+	if err != nil {
+		logger.Error("获取 CNAME 记录失败", "error", err)
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
+	}
+
+	for zone, zoneErr := range result.ZoneErrors {
+		fmt.Fprintf(os.Stderr, "✗ %s: %s\n", zone, cloudflare.FormatError(zoneErr))
+	}
+
+	records := make([]audit.Record, 0, len(result.Matches))
+	for _, match := range result.Matches {
+		records = append(records, audit.Record{
+			Zone:   match.Zone,
+			Name:   match.Record.Name,
+			Target: match.Record.Content,
+		})
+	}
+
+	logger.Info("正在检查 CNAME 目标...", "records", len(records))
+	findings := audit.ScanDangling(ctx, records, audit.DanglingOptions{
+		CloudFrontDomains: cloudFront,
+		Resolver:          probe.NewResolver(auditDanglingResolver, auditDanglingTimeout),
+		Timeout:           auditDanglingTimeout,
+		Concurrency:       auditDanglingConcurrency,
+	})
+
+	dangling := 0
+	data := make([]map[string]interface{}, 0, len(findings))
+	for _, finding := range findings {
+		if finding.Dangling() {
+			dangling++
+		}
+		if finding.Status == audit.StatusOK && !auditDanglingAll {
+			continue
+		}
+		data = append(data, map[string]interface{}{
+			"zone":     finding.Zone,
+			"name":     finding.Name,
+			"target":   finding.Target,
+			"provider": finding.Provider,
+			"status":   finding.Status,
+			"reason":   finding.Reason,
+		})
+	}
+
+	logger.Info("检查完成",
+		"zones", result.SearchedZones,
+		"cname_records", len(records),
+		"checked", len(findings),
+		"dangling", dangling,
+	)
+
+	// 没有结果时也输出空列表，保证 -o json 的输出可以解析
+	if err := GetFormatter().Format(data); err != nil {
+		return fmt.Errorf("格式化输出失败: %w", err)
+	}
+	if len(data) == 0 && len(result.ZoneErrors) == 0 {
+		fmt.Fprintf(os.Stderr, "✓ 检查了 %d 条指向云服务的 CNAME 记录，没有发现悬空记录\n", len(findings))
+	}
+
+	if dangling > 0 {
+		return fmt.Errorf("发现 %d 条悬空 CNAME 记录", dangling)
+	}
+	if len(result.ZoneErrors) > 0 {
+		return fmt.Errorf("有 %d 个域名查询失败", len(result.ZoneErrors))
+	}
+	return nil
+}
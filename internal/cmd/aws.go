@@ -1,7 +1,13 @@
 package cmd
 
 import (
+	"fmt"
+	"os"
+	"time"
+
 	"github.com/spf13/cobra"
+
+	"github.com/ado1t/cloudctl/internal/aws"
 )
 
 // awsCmd 表示 AWS 命令
@@ -41,3 +47,16 @@ var awsCertCmd = &cobra.Command{
 	Short: "ACM 证书管理",
 	Long:  `管理 AWS ACM 证书`,
 }
+
+// waitPollConfig 生成 --wait 使用的轮询配置，进度输出到标准错误
+// timeout 为 0 时使用配置文件中的轮询超时时间
+func waitPollConfig(timeout time.Duration) aws.PollConfig {
+	cfg := aws.DefaultPollConfig()
+	if timeout > 0 {
+		cfg.Timeout = timeout
+	}
+	cfg.OnProgress = func(p aws.PollProgress) {
+		fmt.Fprintf(os.Stderr, "  [%d] 状态: %s (已等待 %s)\n", p.Attempt, p.Status, p.Elapsed.Round(time.Second))
+	}
+	return cfg
+}
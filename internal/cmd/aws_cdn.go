@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
@@ -30,6 +31,8 @@ var (
 	cdnCreateCertificateARN    string
 	cdnCreatePriceClass        string
 	cdnCreateDefaultRootObject string
+	cdnCreateWait              bool
+	cdnCreateWaitTimeout       time.Duration
 
 	// CDN Update 参数
 	cdnUpdateProfile string
@@ -40,9 +43,23 @@ var (
 	cdnInvalidateProfile         string
 	cdnInvalidatePaths           []string
 	cdnInvalidateCallerReference string
+	cdnInvalidateWait            bool
+	cdnInvalidateWaitTimeout     time.Duration
 
 	// CDN Invalidate Status 参数
 	cdnInvalidateStatusProfile string
+
+	// CDN Certs 参数
+	cdnCertsProfile string
+
+	// CDN Rotate Cert 参数
+	cdnRotateProfile     string
+	cdnRotateFrom        string
+	cdnRotateTo          string
+	cdnRotateDryRun      bool
+	cdnRotateWait        bool
+	cdnRotateWaitTimeout time.Duration
+	cdnRotateYes         bool
 )
 
 func init() {
@@ -53,6 +70,8 @@ func init() {
 	awsCdnCmd.AddCommand(cdnUpdateCmd)
 	awsCdnCmd.AddCommand(cdnInvalidateCmd)
 	awsCdnCmd.AddCommand(cdnInvalidateStatusCmd)
+	awsCdnCmd.AddCommand(cdnCertsCmd)
+	awsCdnCmd.AddCommand(cdnRotateCertCmd)
 
 	// CDN List 命令参数
 	cdnListCmd.Flags().StringVarP(&cdnListProfile, "profile", "p", "", "使用指定的 AWS profile")
@@ -60,6 +79,20 @@ func init() {
 	// CDN Get 命令参数
 	cdnGetCmd.Flags().StringVarP(&cdnGetProfile, "profile", "p", "", "使用指定的 AWS profile")
 
+	// CDN Certs 命令参数
+	cdnCertsCmd.Flags().StringVarP(&cdnCertsProfile, "profile", "p", "", "使用指定的 AWS profile")
+
+	// CDN Rotate Cert 命令参数
+	cdnRotateCertCmd.Flags().StringVarP(&cdnRotateProfile, "profile", "p", "", "使用指定的 AWS profile")
+	cdnRotateCertCmd.Flags().StringVar(&cdnRotateFrom, "from", "", "旧证书 ARN（必需）")
+	cdnRotateCertCmd.Flags().StringVar(&cdnRotateTo, "to", "", "新证书 ARN（必需）")
+	cdnRotateCertCmd.Flags().BoolVar(&cdnRotateDryRun, "dry-run", false, "预览模式，只显示受影响的分发")
+	cdnRotateCertCmd.Flags().BoolVar(&cdnRotateWait, "wait", false, "等待所有分发部署完成")
+	cdnRotateCertCmd.Flags().DurationVar(&cdnRotateWaitTimeout, "wait-timeout", 0, "等待超时时间（默认使用配置文件 api.poll.timeout）")
+	cdnRotateCertCmd.Flags().BoolVarP(&cdnRotateYes, "yes", "y", false, "跳过确认提示")
+	cdnRotateCertCmd.MarkFlagRequired("from")
+	cdnRotateCertCmd.MarkFlagRequired("to")
+
 	// CDN Create 命令参数
 	cdnCreateCmd.Flags().StringVarP(&cdnCreateProfile, "profile", "p", "", "使用指定的 AWS profile")
 	cdnCreateCmd.Flags().StringVarP(&cdnCreateConfigFile, "config", "f", "", "批量创建配置文件（YAML 格式）")
@@ -70,6 +103,8 @@ func init() {
 	cdnCreateCmd.Flags().StringVar(&cdnCreateCertificateARN, "certificate-arn", "", "SSL 证书 ARN（可选，使用自定义域名时需要）")
 	cdnCreateCmd.Flags().StringVar(&cdnCreatePriceClass, "price-class", "", "价格等级（可选: PriceClass_100, PriceClass_200, PriceClass_All）")
 	cdnCreateCmd.Flags().StringVar(&cdnCreateDefaultRootObject, "default-root-object", "", "默认根对象（可选，如: index.html）")
+	cdnCreateCmd.Flags().BoolVar(&cdnCreateWait, "wait", false, "等待分发部署完成（仅单个创建）")
+	cdnCreateCmd.Flags().DurationVar(&cdnCreateWaitTimeout, "wait-timeout", 0, "等待超时时间（默认使用配置文件 api.poll.timeout）")
 
 	// CDN Update 命令参数
 	cdnUpdateCmd.Flags().StringVarP(&cdnUpdateProfile, "profile", "p", "", "使用指定的 AWS profile")
@@ -81,6 +116,8 @@ func init() {
 	cdnInvalidateCmd.Flags().StringVarP(&cdnInvalidateProfile, "profile", "p", "", "使用指定的 AWS profile")
 	cdnInvalidateCmd.Flags().StringSliceVar(&cdnInvalidatePaths, "paths", []string{}, "要失效的路径列表（必需，多个用逗号分隔）")
 	cdnInvalidateCmd.Flags().StringVar(&cdnInvalidateCallerReference, "caller-reference", "", "调用者引用（可选，默认自动生成）")
+	cdnInvalidateCmd.Flags().BoolVar(&cdnInvalidateWait, "wait", false, "等待缓存失效完成")
+	cdnInvalidateCmd.Flags().DurationVar(&cdnInvalidateWaitTimeout, "wait-timeout", 0, "等待超时时间（默认使用配置文件 api.poll.timeout）")
 	cdnInvalidateCmd.MarkFlagRequired("paths")
 
 	// CDN Invalidate Status 命令参数
@@ -126,6 +163,9 @@ var cdnCreateCmd = &cobra.Command{
   # 创建基本分发
   cloudctl aws cdn create --origin example.com
 
+  # 创建分发并等待部署完成
+  cloudctl aws cdn create --origin example.com --wait --wait-timeout 30m
+
   # 创建带自定义域名的分发
   cloudctl aws cdn create --origin example.com \
     --aliases cdn.example.com \
@@ -251,6 +291,80 @@ func runCdnGet(cmd *cobra.Command, args []string) error {
 		"aliases":     dist.Aliases,
 		"origins":     dist.Origins,
 		"comment":     dist.Comment,
+		"certificate": dist.CertificateARN,
+	}
+
+	// 输出结果
+	if err := formatter.Format(data); err != nil {
+		return fmt.Errorf("格式化输出失败: %w", err)
+	}
+
+	return nil
+}
+
+// cdnCertsCmd 列出分发使用的证书
+var cdnCertsCmd = &cobra.Command{
+	Use:   "certs",
+	Short: "列出每个 CloudFront 分发使用的证书",
+	Long: `列出每个 CloudFront 分发使用的 ACM 证书及其状态和到期时间。
+
+使用示例:
+  cloudctl aws cdn certs              # 列出所有分发的证书
+  cloudctl aws cdn certs -o json      # JSON 格式输出`,
+	RunE: runCdnCerts,
+}
+
+// runCdnCerts 执行 CDN certs 命令
+func runCdnCerts(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// 创建 AWS 客户端
+	client, err := aws.NewClient(cdnCertsProfile)
+	if err != nil {
+		return fmt.Errorf("创建 AWS 客户端失败: %w", err)
+	}
+
+	logger.Info("正在获取分发证书...")
+
+	items, err := client.GetDistributionCertificates(ctx)
+	if err != nil {
+		return fmt.Errorf("获取分发证书失败: %w", err)
+	}
+
+	if len(items) == 0 {
+		fmt.Println("没有找到分发")
+		return nil
+	}
+
+	// 格式化输出
+	formatter := GetFormatter()
+
+	// 转换为输出格式
+	now := time.Now()
+	data := make([]map[string]interface{}, len(items))
+	for i, item := range items {
+		row := map[string]interface{}{
+			"id":              item.Distribution.ID,
+			"domain_name":     item.Distribution.DomainName,
+			"aliases":         item.Distribution.Aliases,
+			"certificate_arn": item.Distribution.CertificateARN,
+			"cert_domain":     "",
+			"cert_status":     "",
+			"not_after":       "",
+			"days_remaining":  "",
+		}
+		if item.Certificate != nil {
+			row["cert_domain"] = item.Certificate.DomainName
+			row["cert_status"] = item.Certificate.Status
+			// 未签发的证书没有到期时间
+			if !item.Certificate.NotAfter.IsZero() {
+				row["not_after"] = formatTime(item.Certificate.NotAfter)
+				row["days_remaining"] = aws.DaysUntil(now, item.Certificate.NotAfter)
+			}
+		} else if item.Distribution.CertificateARN == "" {
+			row["cert_status"] = "DEFAULT"
+		}
+		data[i] = row
 	}
 
 	// 输出结果
@@ -261,6 +375,124 @@ func runCdnGet(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// cdnRotateCertCmd 轮换分发证书
+var cdnRotateCertCmd = &cobra.Command{
+	Use:   "rotate-cert",
+	Short: "将使用旧证书的分发批量切换到新证书",
+	Long: `找出所有使用旧证书的 CloudFront 分发，校验新证书覆盖每个分发的自定义域名后，
+逐个更新分发的证书配置（使用 ETag 并发控制，配置被并发修改时自动重试）。
+
+新证书必须位于 us-east-1 且已签发；任一分发的自定义域名未被新证书覆盖时不会执行任何更新。
+
+使用示例:
+  # 预览受影响的分发
+  cloudctl aws cdn rotate-cert --from <旧证书 ARN> --to <新证书 ARN> --dry-run
+
+  # 执行轮换并等待部署完成
+  cloudctl aws cdn rotate-cert --from <旧证书 ARN> --to <新证书 ARN> --wait`,
+	RunE: runCdnRotateCert,
+}
+
+// runCdnRotateCert 执行 CDN rotate-cert 命令
+func runCdnRotateCert(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// 创建 AWS 客户端
+	client, err := aws.NewClient(cdnRotateProfile)
+	if err != nil {
+		return fmt.Errorf("创建 AWS 客户端失败: %w", err)
+	}
+
+	logger.Info("正在生成证书轮换计划...", "from", cdnRotateFrom, "to", cdnRotateTo)
+
+	plan, err := client.PlanCertificateRotation(ctx, cdnRotateFrom, cdnRotateTo)
+	if err != nil {
+		return fmt.Errorf("生成证书轮换计划失败: %w", err)
+	}
+
+	if len(plan.Items) == 0 {
+		fmt.Println("没有分发使用旧证书")
+		return nil
+	}
+
+	// 显示计划
+	data := make([]map[string]interface{}, len(plan.Items))
+	for i, item := range plan.Items {
+		data[i] = map[string]interface{}{
+			"id":          item.Distribution.ID,
+			"domain_name": item.Distribution.DomainName,
+			"aliases":     item.Distribution.Aliases,
+			"uncovered":   item.Uncovered,
+			"covered":     len(item.Uncovered) == 0,
+		}
+	}
+	if err := GetFormatter().Format(data); err != nil {
+		return fmt.Errorf("格式化输出失败: %w", err)
+	}
+
+	if !plan.Covered() {
+		return fmt.Errorf("新证书未覆盖部分分发的自定义域名，已取消轮换")
+	}
+
+	if cdnRotateDryRun {
+		fmt.Printf("\n预览模式：以上 %d 个分发将切换到新证书\n", len(plan.Items))
+		return nil
+	}
+
+	if !cdnRotateYes {
+		fmt.Printf("\n确认将以上 %d 个分发切换到新证书 %s?\n", len(plan.Items), plan.ToARN)
+		fmt.Print("输入 'yes' 确认: ")
+
+		var confirm string
+		fmt.Scanln(&confirm)
+
+		if confirm != "yes" {
+			fmt.Println("已取消轮换操作")
+			return nil
+		}
+	}
+
+	results := client.RotateCertificate(ctx, plan)
+
+	failed := 0
+	var updated []string
+	for _, r := range results {
+		if r.Success {
+			fmt.Printf("✓ %s\n", r.DistributionID)
+			updated = append(updated, r.DistributionID)
+		} else {
+			fmt.Printf("✗ %s\n  错误: %s\n", r.DistributionID, r.Error)
+			failed++
+		}
+	}
+
+	waitFailed := 0
+	if cdnRotateWait && len(updated) > 0 {
+		fmt.Printf("\n正在等待分发部署完成...\n")
+		for _, id := range updated {
+			fmt.Printf("%s:\n", id)
+			if _, err := client.WaitForDistributionDeployed(ctx, id, waitPollConfig(cdnRotateWaitTimeout)); err != nil {
+				fmt.Printf("✗ 等待分发 %s 部署失败: %s\n", id, err)
+				waitFailed++
+			}
+		}
+		if waitFailed == 0 {
+			fmt.Printf("✓ 所有分发已部署完成\n")
+		}
+	}
+
+	switch {
+	case failed > 0 && waitFailed > 0:
+		return fmt.Errorf("有 %d 个分发更新失败，%d 个分发等待部署失败", failed, waitFailed)
+	case failed > 0:
+		return fmt.Errorf("有 %d 个分发更新失败", failed)
+	case waitFailed > 0:
+		return fmt.Errorf("有 %d 个分发等待部署失败", waitFailed)
+	}
+
+	return nil
+}
+
 // runCdnCreate 执行 CDN create 命令
 func runCdnCreate(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
@@ -325,6 +557,15 @@ func runCdnCreate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("格式化输出失败: %w", err)
 	}
 
+	if cdnCreateWait {
+		fmt.Printf("\n正在等待分发部署完成...\n")
+		if _, err := client.WaitForDistributionDeployed(ctx, dist.ID, waitPollConfig(cdnCreateWaitTimeout)); err != nil {
+			return fmt.Errorf("等待分发部署失败: %w", err)
+		}
+		fmt.Printf("✓ 分发已部署完成\n")
+		return nil
+	}
+
 	fmt.Printf("\n注意: CloudFront 分发部署通常需要 10-15 分钟\n")
 	fmt.Printf("可以使用以下命令检查部署状态:\n")
 	fmt.Printf("  cloudctl aws cdn get %s\n", dist.ID)
@@ -414,6 +655,9 @@ var cdnInvalidateCmd = &cobra.Command{
   # 失效多个路径
   cloudctl aws cdn invalidate E1234567890ABC --paths "/index.html,/css/*,/js/*"
 
+  # 等待缓存失效完成
+  cloudctl aws cdn invalidate E1234567890ABC --paths "/*" --wait
+
   # 指定 caller reference
   cloudctl aws cdn invalidate E1234567890ABC --paths "/*" --caller-reference "my-invalidation-001"
 
@@ -476,6 +720,15 @@ func runCdnInvalidate(cmd *cobra.Command, args []string) error {
 	fmt.Printf("\n✓ 缓存失效请求已创建\n")
 	fmt.Printf("失效 ID: %s\n", invalidation.ID)
 	fmt.Printf("状态: %s\n", invalidation.Status)
+
+	if cdnInvalidateWait {
+		fmt.Printf("\n正在等待缓存失效完成...\n")
+		if _, err := client.WaitForInvalidationCompleted(ctx, distributionID, invalidation.ID, waitPollConfig(cdnInvalidateWaitTimeout)); err != nil {
+			return fmt.Errorf("等待缓存失效失败: %w", err)
+		}
+		fmt.Printf("✓ 缓存失效已完成\n")
+		return nil
+	}
 	fmt.Printf("\n注意: 缓存失效通常需要 10-15 分钟才能完成\n")
 	fmt.Printf("可以使用以下命令检查失效状态:\n")
 	fmt.Printf("  cloudctl aws cdn invalidate-status %s %s\n", distributionID, invalidation.ID)
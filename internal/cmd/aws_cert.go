@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"go.yaml.in/yaml/v3"
@@ -15,17 +17,57 @@ import (
 
 var (
 	// Cert List 参数
-	certListProfile string
+	certListProfile     string
+	certListRegion      string
+	certListAllRegions  bool
+	certListRegions     []string
+	certListConcurrency int
 
 	// Cert Get 参数
 	certGetProfile string
 
 	// Cert Request 参数
 	certRequestProfile      string
+	certRequestRegion       string
 	certRequestDomain       string
 	certRequestSANs         []string
 	certRequestConfigFile   string
 	certRequestOutputConfig string
+	certRequestKeyAlgorithm string
+	certRequestTags         map[string]string
+	certRequestTransparency string
+	certRequestIdempotency  string
+	certRequestReuse        bool
+	certRequestWait         bool
+	certRequestWaitTimeout  time.Duration
+
+	// Cert Report 参数
+	certReportProfile        string
+	certReportRegion         string
+	certReportExpiringWithin string
+
+	// Cert Import 参数
+	certImportProfile   string
+	certImportRegion    string
+	certImportCertFile  string
+	certImportKeyFile   string
+	certImportChainFile string
+	certImportARN       string
+
+	// Cert Usage 参数
+	certUsageProfile string
+
+	// Cert Delete 参数
+	certDeleteProfile string
+	certDeleteYes     bool
+
+	// Cert Prune 参数
+	certPruneProfile   string
+	certPruneRegion    string
+	certPruneStatuses  []string
+	certPruneOlderThan string
+	certPruneDryRun    bool
+	certPruneYes       bool
 )
 
 func init() {
@@ -33,19 +75,66 @@ func init() {
 	awsCertCmd.AddCommand(certListCmd)
 	awsCertCmd.AddCommand(certGetCmd)
 	awsCertCmd.AddCommand(certRequestCmd)
+	awsCertCmd.AddCommand(certReportCmd)
+	awsCertCmd.AddCommand(certImportCmd)
+	awsCertCmd.AddCommand(certUsageCmd)
+	awsCertCmd.AddCommand(certDeleteCmd)
+	awsCertCmd.AddCommand(certPruneCmd)
 
 	// Cert List 命令参数
 	certListCmd.Flags().StringVarP(&certListProfile, "profile", "p", "", "使用指定的 AWS profile")
+	certListCmd.Flags().StringVar(&certListRegion, "region", "", "ACM 区域（默认使用 profile 配置的区域）")
+	certListCmd.Flags().BoolVar(&certListAllRegions, "all-regions", false, "列出所有配置区域的证书")
+	certListCmd.Flags().StringSliceVar(&certListRegions, "regions", []string{}, "多区域查询的区域列表（覆盖 profile 配置，多个用逗号分隔）")
+	certListCmd.Flags().IntVar(&certListConcurrency, "concurrency", 5, "多区域查询的并发数")
 
 	// Cert Get 命令参数
 	certGetCmd.Flags().StringVarP(&certGetProfile, "profile", "p", "", "使用指定的 AWS profile")
 
 	// Cert Request 命令参数
 	certRequestCmd.Flags().StringVarP(&certRequestProfile, "profile", "p", "", "使用指定的 AWS profile")
+	certRequestCmd.Flags().StringVar(&certRequestRegion, "region", "", "ACM 区域（默认使用 profile 配置的区域，CloudFront 证书须为 us-east-1）")
 	certRequestCmd.Flags().StringVarP(&certRequestDomain, "domain", "d", "", "主域名（单个申请时必需）")
 	certRequestCmd.Flags().StringSliceVar(&certRequestSANs, "san", []string{}, "备用域名（可选，多个用逗号分隔）")
 	certRequestCmd.Flags().StringVarP(&certRequestConfigFile, "config-file", "f", "", "批量申请配置文件（YAML 格式）")
 	certRequestCmd.Flags().StringVar(&certRequestOutputConfig, "output-config", "", "输出 DNS 验证记录配置文件路径（用于 Cloudflare DNS 批量创建）")
+	certRequestCmd.Flags().StringVar(&certRequestKeyAlgorithm, "key-algorithm", "", "密钥算法（如 RSA_2048、EC_prime256v1、EC_secp384r1）")
+	certRequestCmd.Flags().StringToStringVar(&certRequestTags, "tag", map[string]string{}, "证书标签（key=value，可多次指定）")
+	certRequestCmd.Flags().StringVar(&certRequestTransparency, "certificate-transparency", "", "证书透明度日志偏好（ENABLED|DISABLED）")
+	certRequestCmd.Flags().StringVar(&certRequestIdempotency, "idempotency-token", "", "幂等令牌，一小时内重复申请返回同一证书（批量申请时自动生成）")
+	certRequestCmd.Flags().BoolVar(&certRequestReuse, "reuse", false, "存在 SAN 集合完全一致的已签发或待验证证书时直接复用")
+	certRequestCmd.Flags().BoolVar(&certRequestWait, "wait", false, "等待证书签发完成（需先添加 DNS 验证记录，仅单个申请）")
+	certRequestCmd.Flags().DurationVar(&certRequestWaitTimeout, "wait-timeout", 0, "等待超时时间（默认使用配置文件 api.poll.timeout）")
+
+	// Cert Report 命令参数
+	certReportCmd.Flags().StringVarP(&certReportProfile, "profile", "p", "", "使用指定的 AWS profile")
+	certReportCmd.Flags().StringVar(&certReportRegion, "region", "", "ACM 区域（默认使用 profile 配置的区域）")
+	certReportCmd.Flags().StringVar(&certReportExpiringWithin, "expiring-within", "30d", "到期告警阈值（如 30d、720h）")
+
+	// Cert Import 命令参数
+	certImportCmd.Flags().StringVarP(&certImportProfile, "profile", "p", "", "使用指定的 AWS profile")
+	certImportCmd.Flags().StringVar(&certImportRegion, "region", "", "ACM 区域（默认使用 profile 配置的区域；重新导入时使用 ARN 中的区域）")
+	certImportCmd.Flags().StringVar(&certImportCertFile, "cert", "", "证书文件（PEM 格式，必需）")
+	certImportCmd.Flags().StringVar(&certImportKeyFile, "key", "", "私钥文件（PEM 格式，必需）")
+	certImportCmd.Flags().StringVar(&certImportChainFile, "chain", "", "证书链文件（PEM 格式，可选）")
+	certImportCmd.Flags().StringVar(&certImportARN, "arn", "", "重新导入到已有证书的 ARN（用于续期）")
+	certImportCmd.MarkFlagRequired("cert")
+	certImportCmd.MarkFlagRequired("key")
+
+	// Cert Usage 命令参数
+	certUsageCmd.Flags().StringVarP(&certUsageProfile, "profile", "p", "", "使用指定的 AWS profile")
+
+	// Cert Delete 命令参数
+	certDeleteCmd.Flags().StringVarP(&certDeleteProfile, "profile", "p", "", "使用指定的 AWS profile")
+	certDeleteCmd.Flags().BoolVarP(&certDeleteYes, "yes", "y", false, "跳过确认提示")
+
+	// Cert Prune 命令参数
+	certPruneCmd.Flags().StringVarP(&certPruneProfile, "profile", "p", "", "使用指定的 AWS profile")
+	certPruneCmd.Flags().StringVar(&certPruneRegion, "region", "", "ACM 区域（默认使用 profile 配置的区域）")
+	certPruneCmd.Flags().StringSliceVar(&certPruneStatuses, "status", []string{"FAILED", "EXPIRED", "VALIDATION_TIMED_OUT"}, "要清理的证书状态（多个用逗号分隔）")
+	certPruneCmd.Flags().StringVar(&certPruneOlderThan, "older-than", "30d", "只清理创建时间早于该时长的证书（如 30d、720h）")
+	certPruneCmd.Flags().BoolVar(&certPruneDryRun, "dry-run", false, "预览模式，只显示将要删除的证书")
+	certPruneCmd.Flags().BoolVarP(&certPruneYes, "yes", "y", false, "跳过确认提示")
 }
 
 // certListCmd 列出所有证书
@@ -57,7 +146,10 @@ var certListCmd = &cobra.Command{
 使用示例:
   cloudctl aws cert list                    # 使用默认 profile
   cloudctl aws cert list -p aws-prod        # 使用指定 profile
-  cloudctl aws cert list -o json            # JSON 格式输出`,
+  cloudctl aws cert list -o json            # JSON 格式输出
+  cloudctl aws cert list --region ap-east-1 # 列出指定区域的证书
+  cloudctl aws cert list --all-regions      # 列出 profile 中 regions 配置的所有区域
+  cloudctl aws cert list --all-regions --regions us-east-1,ap-east-1`,
 	RunE: runCertList,
 }
 
@@ -66,17 +158,34 @@ func runCertList(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 
 	// 创建 AWS 客户端
-	client, err := aws.NewClient(certListProfile)
+	client, err := aws.NewClientWithRegion(certListProfile, certListRegion)
 	if err != nil {
 		return fmt.Errorf("创建 AWS 客户端失败: %w", err)
 	}
 
-	logger.Info("正在列出 ACM 证书...")
-
 	// 列出证书
-	certificates, err := client.ListCertificates(ctx)
-	if err != nil {
-		return fmt.Errorf("列出证书失败: %w", err)
+	var certificates []aws.Certificate
+	var regionErr error
+	if certListAllRegions {
+		regions := certListRegions
+		if len(regions) == 0 {
+			regions = client.Regions()
+		}
+		if len(regions) == 0 {
+			return fmt.Errorf("未配置区域列表，请在 profile 中配置 regions 或使用 --regions 指定")
+		}
+
+		logger.Info("正在列出多区域 ACM 证书...", "regions", regions)
+		certificates, regionErr = client.ListCertificatesAllRegions(ctx, regions, certListConcurrency)
+		if regionErr != nil && len(certificates) == 0 {
+			return fmt.Errorf("列出证书失败: %w", regionErr)
+		}
+	} else {
+		logger.Info("正在列出 ACM 证书...", "region", client.Region())
+		certificates, err = client.ListCertificates(ctx)
+		if err != nil {
+			return fmt.Errorf("列出证书失败: %w", err)
+		}
 	}
 
 	if len(certificates) == 0 {
@@ -93,13 +202,15 @@ func runCertList(cmd *cobra.Command, args []string) error {
 	data := make([]map[string]interface{}, len(certificates))
 	for i, cert := range certificates {
 		data[i] = map[string]interface{}{
-			"arn":        cert.ARN,
-			"domain":     cert.DomainName,
-			"status":     cert.Status,
-			"type":       cert.Type,
-			"in_use":     cert.InUse,
-			"not_before": formatTime(cert.NotBefore),
-			"not_after":  formatTime(cert.NotAfter),
+			"arn":           cert.ARN,
+			"domain":        cert.DomainName,
+			"region":        cert.Region,
+			"status":        cert.Status,
+			"type":          cert.Type,
+			"key_algorithm": cert.KeyAlgorithm,
+			"in_use":        cert.InUse,
+			"not_before":    formatTime(cert.NotBefore),
+			"not_after":     formatTime(cert.NotAfter),
 		}
 	}
 
@@ -108,6 +219,11 @@ func runCertList(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("格式化输出失败: %w", err)
 	}
 
+	// 部分区域失败时返回错误
+	if regionErr != nil {
+		return fmt.Errorf("部分区域列出证书失败: %w", regionErr)
+	}
+
 	return nil
 }
 
@@ -115,7 +231,7 @@ func runCertList(cmd *cobra.Command, args []string) error {
 var certGetCmd = &cobra.Command{
 	Use:   "get <certificate-arn>",
 	Short: "获取 ACM 证书详情",
-	Long: `获取指定 ACM 证书的详细信息。
+	Long: `获取指定 ACM 证书的详细信息，证书所在区域从 ARN 中自动识别。
 
 使用示例:
   cloudctl aws cert get arn:aws:acm:us-east-1:123456789012:certificate/xxx
@@ -163,8 +279,10 @@ func runCertGet(cmd *cobra.Command, args []string) error {
 	data := map[string]interface{}{
 		"arn":                cert.ARN,
 		"domain":             cert.DomainName,
+		"region":             cert.Region,
 		"status":             cert.Status,
 		"type":               cert.Type,
+		"key_algorithm":      cert.KeyAlgorithm,
 		"in_use":             cert.InUse,
 		"issued_at":          formatTime(cert.IssuedAt),
 		"not_before":         formatTime(cert.NotBefore),
@@ -200,9 +318,21 @@ var certRequestCmd = &cobra.Command{
   # 批量申请证书（使用配置文件）
   cloudctl aws cert request -f certificates.yaml
 
+  # 申请证书并等待签发完成（需在等待期间添加 DNS 验证记录）
+  cloudctl aws cert request -d example.com --wait --wait-timeout 1h
+
+  # 存在 SAN 集合一致的证书时直接复用
+  cloudctl aws cert request -d example.com --san www.example.com --reuse
+
   # 批量申请证书并生成 DNS 验证记录配置文件
   cloudctl aws cert request -f certificates.yaml --output-config dns-validation.yaml
 
+  # 申请 ECDSA 证书并添加标签
+  cloudctl aws cert request -d example.com --key-algorithm EC_prime256v1 --tag team=web --tag env=prod
+
+  # 为 ap-east-1 的负载均衡器申请证书
+  cloudctl aws cert request -d example.com --region ap-east-1
+
   # 使用指定 profile
   cloudctl aws cert request -d example.com -p aws-prod`,
 	RunE: runCertRequest,
@@ -213,7 +343,7 @@ func runCertRequest(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 
 	// 创建 AWS 客户端
-	client, err := aws.NewClient(certRequestProfile)
+	client, err := aws.NewClientWithRegion(certRequestProfile, certRequestRegion)
 	if err != nil {
 		return fmt.Errorf("创建 AWS 客户端失败: %w", err)
 	}
@@ -234,15 +364,35 @@ func runCertRequest(cmd *cobra.Command, args []string) error {
 	input := &aws.RequestCertificateInput{
 		DomainName:              certRequestDomain,
 		SubjectAlternativeNames: certRequestSANs,
+		KeyAlgorithm:            certRequestKeyAlgorithm,
+		Tags:                    certRequestTags,
+		CertificateTransparency: certRequestTransparency,
+		IdempotencyToken:        certRequestIdempotency,
 	}
 
-	// 申请证书
-	cert, err := client.RequestCertificate(ctx, input)
-	if err != nil {
-		return fmt.Errorf("申请证书失败: %w", err)
+	// 复用已有证书
+	var cert *aws.Certificate
+	reused := false
+	if certRequestReuse {
+		existing, err := client.ListCertificates(ctx)
+		if err != nil {
+			return fmt.Errorf("列出证书失败: %w", err)
+		}
+		cert, err = client.FindReusableCertificate(ctx, existing, input)
+		if err != nil {
+			return fmt.Errorf("查找可复用证书失败: %w", err)
+		}
+		reused = cert != nil
 	}
 
-	logger.Info("成功申请证书", "arn", cert.ARN)
+	// 申请证书
+	if !reused {
+		cert, err = client.RequestCertificate(ctx, input)
+		if err != nil {
+			return fmt.Errorf("申请证书失败: %w", err)
+		}
+		logger.Info("成功申请证书", "arn", cert.ARN)
+	}
 
 	// 格式化输出
 	formatter := GetFormatter()
@@ -264,6 +414,7 @@ func runCertRequest(cmd *cobra.Command, args []string) error {
 		"domain":             cert.DomainName,
 		"status":             cert.Status,
 		"validation_records": validationRecords,
+		"reused":             reused,
 	}
 
 	// 输出结果
@@ -272,20 +423,34 @@ func runCertRequest(cmd *cobra.Command, args []string) error {
 	}
 
 	// 显示提示信息
-	fmt.Printf("\n✓ 证书申请已提交\n")
+	if reused {
+		fmt.Printf("\n✓ 已复用现有证书\n")
+	} else {
+		fmt.Printf("\n✓ 证书申请已提交\n")
+	}
 	fmt.Printf("证书 ARN: %s\n", cert.ARN)
 	fmt.Printf("状态: %s\n\n", cert.Status)
 
-	if len(cert.ValidationRecords) > 0 {
+	if len(cert.ValidationRecords) > 0 && cert.Status != "ISSUED" {
 		fmt.Printf("请在 DNS 中添加以下验证记录:\n\n")
 		for _, record := range cert.ValidationRecords {
 			fmt.Printf("类型: %s\n", record.Type)
 			fmt.Printf("名称: %s\n", record.Name)
 			fmt.Printf("值: %s\n\n", record.Value)
 		}
-		fmt.Printf("注意: DNS 验证通常需要几分钟到几小时才能完成\n")
-		fmt.Printf("可以使用以下命令检查证书状态:\n")
-		fmt.Printf("  cloudctl aws cert get %s\n", cert.ARN)
+		if !certRequestWait {
+			fmt.Printf("注意: DNS 验证通常需要几分钟到几小时才能完成\n")
+			fmt.Printf("可以使用以下命令检查证书状态:\n")
+			fmt.Printf("  cloudctl aws cert get %s\n", cert.ARN)
+		}
+	}
+
+	if certRequestWait && cert.Status != "ISSUED" {
+		fmt.Printf("正在等待证书签发...\n")
+		if _, err := client.WaitForCertificateIssued(ctx, cert.ARN, waitPollConfig(certRequestWaitTimeout)); err != nil {
+			return fmt.Errorf("等待证书签发失败: %w", err)
+		}
+		fmt.Printf("✓ 证书已签发\n")
 	}
 
 	return nil
@@ -311,6 +476,13 @@ func runBatchCertRequest(ctx context.Context, client *aws.Client) error {
 		return fmt.Errorf("配置文件中没有证书申请记录")
 	}
 
+	// 命令行开启复用时对所有申请生效
+	if certRequestReuse {
+		for i := range config.Certificates {
+			config.Certificates[i].Reuse = true
+		}
+	}
+
 	logger.Info("开始批量申请证书", "count", len(config.Certificates))
 	fmt.Printf("\n开始批量申请 %d 个证书...\n\n", len(config.Certificates))
 
@@ -324,7 +496,8 @@ func runBatchCertRequest(ctx context.Context, client *aws.Client) error {
 	fmt.Printf("%s\n\n", separator)
 	fmt.Printf("总计: %d\n", result.Total)
 	fmt.Printf("成功: %d\n", result.Success)
-	fmt.Printf("失败: %d\n\n", result.Failed)
+	fmt.Printf("失败: %d\n", result.Failed)
+	fmt.Printf("复用: %d\n\n", result.Reused)
 
 	// 显示详细结果
 	if result.Success > 0 {
@@ -333,7 +506,11 @@ func runBatchCertRequest(ctx context.Context, client *aws.Client) error {
 		fmt.Printf("%s\n", line)
 		for _, r := range result.Results {
 			if r.Success {
-				fmt.Printf("✓ %s\n", r.Domain)
+				if r.Reused {
+					fmt.Printf("✓ %s (复用已有证书)\n", r.Domain)
+				} else {
+					fmt.Printf("✓ %s\n", r.Domain)
+				}
 				fmt.Printf("  ARN: %s\n\n", r.ARN)
 			}
 		}
@@ -384,6 +561,490 @@ func runBatchCertRequest(ctx context.Context, client *aws.Client) error {
 	return nil
 }
 
+// certReportCmd 证书到期与续期报告
+var certReportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "生成 ACM 证书到期与续期报告",
+	Long: `汇总所有 ACM 证书的剩余有效期、续期状态、使用状态和验证状态。
+
+以下情况视为告警，命令将以非零状态码退出：
+  - 剩余有效期不超过 --expiring-within 指定的阈值（含已过期）
+  - 托管续期失败（FAILED）
+  - 托管续期卡在待验证状态（PENDING_VALIDATION）
+
+使用示例:
+  cloudctl aws cert report                          # 默认 30 天阈值
+  cloudctl aws cert report --expiring-within 14d    # 14 天阈值
+  cloudctl aws cert report -o json                  # JSON 格式输出
+  cloudctl aws cert report -o csv > report.csv      # CSV 格式输出`,
+	RunE: runCertReport,
+}
+
+// runCertReport 执行 cert report 命令
+func runCertReport(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	threshold, err := parseDayDuration(certReportExpiringWithin)
+	if err != nil {
+		return err
+	}
+
+	// 创建 AWS 客户端
+	client, err := aws.NewClientWithRegion(certReportProfile, certReportRegion)
+	if err != nil {
+		return fmt.Errorf("创建 AWS 客户端失败: %w", err)
+	}
+
+	logger.Info("正在生成证书到期报告...", "threshold", threshold)
+
+	report, err := client.GenerateCertificateReport(ctx, threshold)
+	if err != nil {
+		return fmt.Errorf("生成证书报告失败: %w", err)
+	}
+
+	if len(report.Items) == 0 {
+		fmt.Println("没有找到已签发的证书")
+		return nil
+	}
+
+	// 格式化输出
+	formatter := GetFormatter()
+
+	// 转换为输出格式
+	data := make([]map[string]interface{}, len(report.Items))
+	for i, item := range report.Items {
+		data[i] = map[string]interface{}{
+			"arn":            item.ARN,
+			"domain":         item.DomainName,
+			"region":         item.Region,
+			"status":         item.Status,
+			"days_remaining": item.DaysRemaining,
+			"not_after":      formatTime(item.NotAfter),
+			"renewal_status": item.RenewalStatus,
+			"in_use":         item.InUse,
+			"validation":     item.ValidationStatus,
+			"breached":       item.Breached,
+			"reason":         strings.Join(item.Reasons, "; "),
+		}
+	}
+
+	// 输出结果
+	if err := formatter.Format(data); err != nil {
+		return fmt.Errorf("格式化输出失败: %w", err)
+	}
+
+	if report.Breached > 0 {
+		return fmt.Errorf("有 %d 个证书触发告警", report.Breached)
+	}
+
+	return nil
+}
+
+// certImportCmd 导入第三方证书
+var certImportCmd = &cobra.Command{
+	Use:   "import",
+	Short: "导入第三方证书到 ACM",
+	Long: `从 PEM 文件导入第三方证书到 ACM。
+
+导入前会在本地校验证书：
+  - 私钥与证书匹配
+  - 证书链顺序正确（每个证书由其后一个证书签发）
+  - 证书在有效期内
+  - 证书包含 SAN 域名
+
+使用示例:
+  # 导入新证书
+  cloudctl aws cert import --cert cert.pem --key key.pem --chain chain.pem
+
+  # 续期：重新导入到已有证书（ARN 不变，关联资源无需修改）
+  cloudctl aws cert import --cert cert.pem --key key.pem --chain chain.pem \
+    --arn arn:aws:acm:us-east-1:123456789012:certificate/xxx`,
+	RunE: runCertImport,
+}
+
+// runCertImport 执行 cert import 命令
+func runCertImport(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// 读取 PEM 文件
+	certPEM, err := os.ReadFile(certImportCertFile)
+	if err != nil {
+		return fmt.Errorf("读取证书文件失败: %w", err)
+	}
+	keyPEM, err := os.ReadFile(certImportKeyFile)
+	if err != nil {
+		return fmt.Errorf("读取私钥文件失败: %w", err)
+	}
+	var chainPEM []byte
+	if certImportChainFile != "" {
+		chainPEM, err = os.ReadFile(certImportChainFile)
+		if err != nil {
+			return fmt.Errorf("读取证书链文件失败: %w", err)
+		}
+	}
+
+	// 创建 AWS 客户端
+	client, err := aws.NewClientWithRegion(certImportProfile, certImportRegion)
+	if err != nil {
+		return fmt.Errorf("创建 AWS 客户端失败: %w", err)
+	}
+
+	logger.Info("正在导入证书...", "cert", certImportCertFile, "arn", certImportARN)
+
+	result, err := client.ImportCertificate(ctx, &aws.ImportCertificateInput{
+		CertificatePEM: certPEM,
+		PrivateKeyPEM:  keyPEM,
+		ChainPEM:       chainPEM,
+		CertificateARN: certImportARN,
+	})
+	if err != nil {
+		return fmt.Errorf("导入证书失败: %w", err)
+	}
+
+	// 格式化输出
+	formatter := GetFormatter()
+
+	data := map[string]interface{}{
+		"arn":               result.ARN,
+		"domain":            result.Domain,
+		"subject_alt_names": result.SANs,
+		"issuer":            result.Issuer,
+		"not_before":        formatTime(result.NotBefore),
+		"not_after":         formatTime(result.NotAfter),
+		"reimport":          result.Reimport,
+	}
+
+	if err := formatter.Format(data); err != nil {
+		return fmt.Errorf("格式化输出失败: %w", err)
+	}
+
+	return nil
+}
+
+// certUsageCmd 查看证书被哪些分发使用
+var certUsageCmd = &cobra.Command{
+	Use:   "usage [certificate-arn]",
+	Short: "查看证书被哪些 CloudFront 分发使用",
+	Long: `将 ACM 证书与所有 CloudFront 分发的证书配置进行对照，列出使用每个证书的分发及其自定义域名。
+
+不指定 ARN 时列出所有证书（按到期时间排序），未被任何分发使用的证书也会列出。
+
+使用示例:
+  cloudctl aws cert usage
+  cloudctl aws cert usage arn:aws:acm:us-east-1:123456789012:certificate/xxx
+  cloudctl aws cert usage -o json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runCertUsage,
+}
+
+// runCertUsage 执行 cert usage 命令
+func runCertUsage(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	certificateARN := ""
+	if len(args) > 0 {
+		certificateARN = args[0]
+	}
+
+	// 创建 AWS 客户端
+	client, err := aws.NewClient(certUsageProfile)
+	if err != nil {
+		return fmt.Errorf("创建 AWS 客户端失败: %w", err)
+	}
+
+	logger.Info("正在获取证书使用情况...", "arn", certificateARN)
+
+	usage, err := client.GetCertificateUsage(ctx, certificateARN)
+	if err != nil {
+		return fmt.Errorf("获取证书使用情况失败: %w", err)
+	}
+
+	if len(usage) == 0 {
+		fmt.Println("没有找到证书")
+		return nil
+	}
+
+	// 格式化输出
+	formatter := GetFormatter()
+
+	// 转换为输出格式，每个证书与分发的组合为一行
+	var data []map[string]interface{}
+	for _, u := range usage {
+		base := map[string]interface{}{
+			"arn":       u.Certificate.ARN,
+			"domain":    u.Certificate.DomainName,
+			"status":    u.Certificate.Status,
+			"in_use":    u.Certificate.InUse,
+			"not_after": formatTime(u.Certificate.NotAfter),
+		}
+
+		if len(u.Distributions) == 0 {
+			row := copyRow(base)
+			row["distribution_id"] = ""
+			row["aliases"] = []string{}
+			data = append(data, row)
+			continue
+		}
+
+		for _, dist := range u.Distributions {
+			row := copyRow(base)
+			row["distribution_id"] = dist.ID
+			row["aliases"] = dist.Aliases
+			data = append(data, row)
+		}
+	}
+
+	// 输出结果
+	if err := formatter.Format(data); err != nil {
+		return fmt.Errorf("格式化输出失败: %w", err)
+	}
+
+	return nil
+}
+
+// copyRow 复制输出行
+func copyRow(row map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(row)+2)
+	for k, v := range row {
+		result[k] = v
+	}
+	return result
+}
+
+// certDeleteCmd 删除证书
+var certDeleteCmd = &cobra.Command{
+	Use:   "delete <certificate-arn>...",
+	Short: "删除 ACM 证书",
+	Long: `删除一个或多个 ACM 证书。
+
+正在被使用的证书（如关联了 CloudFront 分发或负载均衡器）会被拒绝删除，
+并列出使用该证书的资源。删除前需要输入 'yes' 确认。
+
+使用示例:
+  cloudctl aws cert delete arn:aws:acm:us-east-1:123456789012:certificate/xxx
+  cloudctl aws cert delete <arn1> <arn2> --yes`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runCertDelete,
+}
+
+// runCertDelete 执行 cert delete 命令
+func runCertDelete(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// 创建 AWS 客户端
+	client, err := aws.NewClient(certDeleteProfile)
+	if err != nil {
+		return fmt.Errorf("创建 AWS 客户端失败: %w", err)
+	}
+
+	// 获取证书详情，区分可删除与被使用的证书
+	var deletable, inUse []aws.Certificate
+	failed := 0
+	for _, arn := range args {
+		cert, err := client.GetCertificate(ctx, arn)
+		if err != nil {
+			fmt.Printf("✗ %s\n  错误: %v\n", arn, err)
+			failed++
+			continue
+		}
+		if cert.InUse {
+			inUse = append(inUse, *cert)
+			continue
+		}
+		deletable = append(deletable, *cert)
+	}
+
+	printInUseCertificates(inUse)
+
+	deleted, deleteFailed := confirmAndDeleteCertificates(ctx, client, deletable, certDeleteYes)
+	failed += deleteFailed
+
+	if deleted > 0 {
+		fmt.Printf("\n✓ 已删除 %d 个证书\n", deleted)
+	}
+	if failed > 0 || len(inUse) > 0 {
+		return fmt.Errorf("%d 个证书删除失败，%d 个证书正在被使用", failed, len(inUse))
+	}
+
+	return nil
+}
+
+// certPruneCmd 清理无用证书
+var certPruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "清理失败、过期或验证超时的 ACM 证书",
+	Long: `批量清理指定状态且创建时间超过指定时长的 ACM 证书。
+
+正在被使用的证书不会被删除，会单独列出其使用方。删除前需要输入 'yes' 确认。
+
+使用示例:
+  # 预览将要清理的证书
+  cloudctl aws cert prune --dry-run
+
+  # 清理 30 天前创建的失败、过期和验证超时的证书
+  cloudctl aws cert prune --status FAILED,EXPIRED,VALIDATION_TIMED_OUT --older-than 30d
+
+  # 清理长期未验证的证书
+  cloudctl aws cert prune --status PENDING_VALIDATION --older-than 7d --yes`,
+	RunE: runCertPrune,
+}
+
+// runCertPrune 执行 cert prune 命令
+func runCertPrune(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	statuses, err := aws.ParseCertificateStatuses(certPruneStatuses)
+	if err != nil {
+		return err
+	}
+	olderThan, err := parseDayDuration(certPruneOlderThan)
+	if err != nil {
+		return err
+	}
+
+	// 创建 AWS 客户端
+	client, err := aws.NewClientWithRegion(certPruneProfile, certPruneRegion)
+	if err != nil {
+		return fmt.Errorf("创建 AWS 客户端失败: %w", err)
+	}
+
+	logger.Info("正在查找可清理的证书...", "statuses", statuses, "older_than", olderThan)
+
+	certificates, err := client.ListCertificates(ctx)
+	if err != nil {
+		return fmt.Errorf("列出证书失败: %w", err)
+	}
+
+	candidates, inUse := aws.SelectPruneCandidates(certificates, statuses, olderThan, time.Now())
+
+	// 补充使用方信息
+	for i, cert := range inUse {
+		if detail, err := client.GetCertificate(ctx, cert.ARN); err == nil {
+			inUse[i] = *detail
+		}
+	}
+	printInUseCertificates(inUse)
+
+	// 预览结果输出到 stdout，提示输出到 stderr，保证 -o json/csv 的输出可以解析
+	if certPruneDryRun {
+		if len(candidates) == 0 {
+			fmt.Fprintln(os.Stderr, "没有需要清理的证书")
+		} else {
+			fmt.Fprintf(os.Stderr, "预览模式：以下 %d 个证书将被删除\n\n", len(candidates))
+		}
+		return GetFormatter().Format(certificatesToRows(candidates))
+	}
+
+	if len(candidates) == 0 {
+		fmt.Println("没有需要清理的证书")
+		return nil
+	}
+
+	deleted, failed := confirmAndDeleteCertificates(ctx, client, candidates, certPruneYes)
+	if deleted > 0 {
+		fmt.Printf("\n✓ 已删除 %d 个证书\n", deleted)
+	}
+	if failed > 0 {
+		return fmt.Errorf("有 %d 个证书删除失败", failed)
+	}
+
+	return nil
+}
+
+// printInUseCertificates 在 stderr 显示被使用而拒绝删除的证书及其使用方
+func printInUseCertificates(certs []aws.Certificate) {
+	if len(certs) == 0 {
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "以下证书正在被使用，拒绝删除:\n")
+	for _, cert := range certs {
+		fmt.Fprintf(os.Stderr, "✗ %s (%s)\n", cert.ARN, cert.DomainName)
+		for _, resource := range cert.InUseBy {
+			fmt.Fprintf(os.Stderr, "    使用方: %s\n", resource)
+		}
+	}
+	fmt.Fprintln(os.Stderr)
+}
+
+// confirmAndDeleteCertificates 确认后逐个删除证书，返回成功和失败的数量
+func confirmAndDeleteCertificates(ctx context.Context, client *aws.Client, certs []aws.Certificate, skipConfirm bool) (deleted, failed int) {
+	if len(certs) == 0 {
+		return 0, 0
+	}
+
+	if !skipConfirm {
+		fmt.Printf("确认删除以下 %d 个证书?\n", len(certs))
+		for _, cert := range certs {
+			fmt.Printf("  %s  %s  %s\n", cert.DomainName, cert.Status, cert.ARN)
+		}
+		fmt.Print("\n输入 'yes' 确认删除: ")
+
+		var confirm string
+		fmt.Scanln(&confirm)
+
+		if confirm != "yes" {
+			fmt.Println("已取消删除操作")
+			return 0, 0
+		}
+	}
+
+	for _, cert := range certs {
+		if err := client.DeleteCertificate(ctx, cert.ARN); err != nil {
+			logger.Error("删除证书失败", "arn", cert.ARN, "error", err)
+			fmt.Printf("✗ %s\n  错误: %v\n", cert.ARN, err)
+			failed++
+			continue
+		}
+		fmt.Printf("✓ 已删除 %s (%s)\n", cert.ARN, cert.DomainName)
+		deleted++
+	}
+
+	return deleted, failed
+}
+
+// certificatesToRows 将证书列表转换为输出格式
+func certificatesToRows(certs []aws.Certificate) []map[string]interface{} {
+	data := make([]map[string]interface{}, len(certs))
+	for i, cert := range certs {
+		data[i] = map[string]interface{}{
+			"arn":        cert.ARN,
+			"domain":     cert.DomainName,
+			"region":     cert.Region,
+			"status":     cert.Status,
+			"type":       cert.Type,
+			"in_use":     cert.InUse,
+			"created_at": formatTime(cert.CreatedAt),
+		}
+	}
+	return data
+}
+
+// parseDayDuration 解析时长，支持天数（30d 或 30）以及 Go 时长格式（720h）
+func parseDayDuration(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, fmt.Errorf("时长不能为空")
+	}
+
+	days := strings.TrimSuffix(value, "d")
+	if n, err := strconv.Atoi(days); err == nil {
+		if n < 0 {
+			return 0, fmt.Errorf("时长不能为负数: %s", value)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("无效的时长: %s（示例: 30d、720h）", value)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("时长不能为负数: %s", value)
+	}
+	return d, nil
+}
+
 // formatTime 格式化时间
 func formatTime(t interface{}) string {
 	switch v := t.(type) {
@@ -433,6 +1094,11 @@ func generateDNSConfig(result *aws.BatchRequestResult) (*DNSBatchConfig, error)
 		}
 
 		for _, valRecord := range certResult.ValidationRecords {
+			// 复用的证书中已验证通过的记录无需再次创建
+			if certResult.Reused && valRecord.Status == "SUCCESS" {
+				continue
+			}
+
 			// 从验证记录的 Name 中提取域名
 			// 例如: _c93b9a33d8bde7910f5e9680040b841c.example.com. -> example.com
 			zone := extractZoneFromValidationName(valRecord.Name)
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"testing"
+	"time"
 )
 
 func TestExtractZoneFromValidationName(t *testing.T) {
@@ -73,3 +74,31 @@ func TestExtractSubdomainFromValidationName(t *testing.T) {
 		})
 	}
 }
+
+func TestParseDayDuration(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected time.Duration
+		wantErr  bool
+	}{
+		{"30d", 30 * 24 * time.Hour, false},
+		{"7", 7 * 24 * time.Hour, false},
+		{"0d", 0, false},
+		{"720h", 720 * time.Hour, false},
+		{"-1d", 0, true},
+		{"", 0, true},
+		{"abc", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := parseDayDuration(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseDayDuration(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && result != tt.expected {
+				t.Errorf("parseDayDuration(%q) = %v, want %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ var cfCmd = &cobra.Command{
 使用示例:
   cloudctl cf zone list              # 列出所有域名
   cloudctl cf zone create example.com # 创建域名
+  cloudctl cf zone get example.com    # 查看域名详情
   cloudctl cf dns list example.com   # 列出 DNS 记录
   cloudctl cf cache purge example.com --purge-all # 清除所有缓存`,
 }
@@ -21,9 +21,14 @@ func init() {
 	cfDnsCmd.AddCommand(cfDnsUpdateCmd)
 	cfDnsCmd.AddCommand(cfDnsDeleteCmd)
 	cfDnsCmd.AddCommand(cfDnsBatchCreateCmd)
+	cfDnsCmd.AddCommand(cfDnsBatchUpdateCmd)
+	cfDnsCmd.AddCommand(cfDnsBatchDeleteCmd)
+	cfDnsCmd.AddCommand(cfDnsSearchCmd)
+	cfDnsCmd.AddCommand(cfDnsReplaceCmd)
 
 	// dns list 命令参数
 	cfDnsListCmd.Flags().StringP("type", "t", "", "过滤记录类型 (A, AAAA, CNAME 等)")
+	cfDnsListCmd.Flags().StringSlice("tag", nil, "按标签过滤，格式: name 或 name:value，多个标签需要同时匹配")
 
 	// dns create 命令参数
 	cfDnsCreateCmd.Flags().StringP("type", "t", "", "记录类型 (A, CNAME)")
@@ -31,19 +36,72 @@ func init() {
 	cfDnsCreateCmd.Flags().String("content", "", "记录内容")
 	cfDnsCreateCmd.Flags().Float64("ttl", 1, "TTL (1 = 自动)")
 	cfDnsCreateCmd.Flags().Bool("proxied", false, "启用 Cloudflare 代理")
+	cfDnsCreateCmd.Flags().String("comment", "", "记录备注")
+	cfDnsCreateCmd.Flags().StringSlice("tag", nil, "记录标签，格式: name:value（可多次指定）")
 	cfDnsCreateCmd.Flags().String("config", "", "批量操作配置文件 (YAML)")
+	cfDnsCreateCmd.Flags().Bool("skip-lint", false, "跳过创建前的记录检查")
 
 	// dns batch-create 命令参数
 	cfDnsBatchCreateCmd.Flags().String("config", "", "批量操作配置文件 (YAML)")
 	cfDnsBatchCreateCmd.Flags().Bool("dry-run", false, "预览模式，不实际执行")
 	cfDnsBatchCreateCmd.Flags().Int("concurrency", 1, "并发数 (1-10)")
+	cfDnsBatchCreateCmd.Flags().String("on-conflict", "", "记录已存在时的处理策略 (fail|skip|update)，覆盖配置文件中的 on_conflict")
+	cfDnsBatchCreateCmd.Flags().Bool("skip-lint", false, "跳过创建前的记录检查")
+	cfDnsBatchCreateCmd.Flags().String("owner-tag", "", "所有权标签，覆盖配置文件中的 owner_tag (例如 "+cloudflare.DefaultOwnerTag+")")
 	cfDnsBatchCreateCmd.MarkFlagRequired("config")
 
+	// dns batch-update 命令参数
+	cfDnsBatchUpdateCmd.Flags().String("config", "", "批量操作配置文件 (YAML)")
+	cfDnsBatchUpdateCmd.Flags().Bool("dry-run", false, "预览模式，不实际执行")
+	cfDnsBatchUpdateCmd.Flags().Int("concurrency", 1, "并发数 (1-10)")
+	cfDnsBatchUpdateCmd.Flags().Bool("skip-lint", false, "跳过更新前的记录检查")
+	cfDnsBatchUpdateCmd.Flags().String("owner-tag", "", "所有权标签，覆盖配置文件中的 owner_tag (例如 "+cloudflare.DefaultOwnerTag+")")
+	cfDnsBatchUpdateCmd.MarkFlagRequired("config")
+
+	// dns batch-delete 命令参数
+	cfDnsBatchDeleteCmd.Flags().String("config", "", "批量操作配置文件 (YAML)")
+	cfDnsBatchDeleteCmd.Flags().Bool("dry-run", false, "预览模式，不实际执行")
+	cfDnsBatchDeleteCmd.Flags().Int("concurrency", 1, "并发数 (1-10)")
+	cfDnsBatchDeleteCmd.Flags().BoolP("yes", "y", false, "跳过确认提示")
+	cfDnsBatchDeleteCmd.Flags().String("owner-tag", "", "所有权标签，覆盖配置文件中的 owner_tag (例如 "+cloudflare.DefaultOwnerTag+")")
+	cfDnsBatchDeleteCmd.MarkFlagRequired("config")
+
+	// dns search 命令参数
+	cfDnsSearchCmd.Flags().String("content", "", "记录内容匹配模式")
+	cfDnsSearchCmd.Flags().StringP("name", "n", "", "记录名称匹配模式")
+	cfDnsSearchCmd.Flags().StringP("type", "t", "", "记录类型 (A, AAAA, CNAME 等)")
+	cfDnsSearchCmd.Flags().Bool("regex", false, "按正则表达式匹配（默认按通配符匹配）")
+	cfDnsSearchCmd.Flags().StringSlice("zones", nil, "只在指定域名中搜索，多个域名用逗号分隔")
+	cfDnsSearchCmd.Flags().Int("concurrency", cloudflare.DefaultSearchConcurrency, "并发查询的域名数 (1-10)")
+
+	// dns replace 命令参数
+	cfDnsReplaceCmd.Flags().String("from", "", "要替换的记录内容 (完全匹配)")
+	cfDnsReplaceCmd.Flags().String("to", "", "新的记录内容")
+	cfDnsReplaceCmd.Flags().StringP("type", "t", "", "只替换指定类型的记录 (A, AAAA, CNAME)")
+	cfDnsReplaceCmd.Flags().StringSlice("zones", nil, "只在指定域名中替换，多个域名用逗号分隔")
+	cfDnsReplaceCmd.Flags().Bool("apply", false, "执行替换（默认只显示计划）")
+	cfDnsReplaceCmd.Flags().Int("concurrency", cloudflare.DefaultSearchConcurrency, "并发数 (1-10)")
+	cfDnsReplaceCmd.Flags().String("rollback-log", "", "回滚日志文件路径 (默认: dns-replace-<时间>.jsonl)")
+	cfDnsReplaceCmd.MarkFlagRequired("from")
+	cfDnsReplaceCmd.MarkFlagRequired("to")
+
 	// dns update 命令参数
 	cfDnsUpdateCmd.Flags().String("content", "", "新的记录内容")
 	cfDnsUpdateCmd.Flags().Float64("ttl", 0, "新的 TTL")
 	cfDnsUpdateCmd.Flags().Bool("proxied", false, "是否启用代理")
 	cfDnsUpdateCmd.Flags().Bool("no-proxied", false, "禁用代理")
+	cfDnsUpdateCmd.Flags().String("comment", "", "新的记录备注")
+	cfDnsUpdateCmd.Flags().StringSlice("tag", nil, "替换记录标签，格式: name:value（可多次指定）")
+	cfDnsUpdateCmd.Flags().StringP("name", "n", "", "按记录名称选择 (支持相对名称、完整域名和 @)")
+	cfDnsUpdateCmd.Flags().StringP("type", "t", "", "按记录类型选择")
+	cfDnsUpdateCmd.Flags().Bool("all", false, "更新所有匹配的记录")
+	cfDnsUpdateCmd.Flags().Bool("skip-lint", false, "跳过更新前的记录检查")
+
+	// dns delete 命令参数
+	cfDnsDeleteCmd.Flags().StringP("name", "n", "", "按记录名称选择 (支持相对名称、完整域名和 @)")
+	cfDnsDeleteCmd.Flags().StringP("type", "t", "", "按记录类型选择")
+	cfDnsDeleteCmd.Flags().Bool("all", false, "删除所有匹配的记录")
+	cfDnsDeleteCmd.Flags().BoolP("yes", "y", false, "跳过确认提示")
 }
 
 // cfDnsListCmd 列出 DNS 记录
@@ -58,11 +116,14 @@ var cfDnsListCmd = &cobra.Command{
   - 记录内容
   - TTL
   - 是否启用代理
+  - 备注和标签
 
 使用示例:
   cloudctl cf dns list example.com                    # 列出所有记录
   cloudctl cf dns list example.com --type A           # 只列出 A 记录
   cloudctl cf dns list example.com -t CNAME           # 只列出 CNAME 记录
+  cloudctl cf dns list example.com --tag team:web     # 只列出带有 team:web 标签的记录
+  cloudctl cf dns list example.com --tag team         # 只列出带有 team 标签（任意值）的记录
   cloudctl cf dns list example.com -o json            # JSON 格式输出`,
 	Args: cobra.ExactArgs(1),
 	RunE: runDNSList,
@@ -76,6 +137,7 @@ func runDNSList(cmd *cobra.Command, args []string) error {
 	// 获取参数
 	profile, _ := cmd.Flags().GetString("profile")
 	recordType, _ := cmd.Flags().GetString("type")
+	tags, _ := cmd.Flags().GetStringSlice("tag")
 
 	// 创建 Cloudflare 客户端
 	logger.Debug("创建 Cloudflare 客户端", "profile", profile)
@@ -104,6 +166,7 @@ func runDNSList(cmd *cobra.Command, args []string) error {
 		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
 		os.Exit(cloudflare.GetExitCode(err))
 	}
+	records = cloudflare.FilterDNSRecordsByTags(records, tags)
 
 	if len(records) == 0 {
 		logger.Info("未找到任何 DNS 记录")
@@ -123,6 +186,8 @@ func runDNSList(cmd *cobra.Command, args []string) error {
 			"content": record.Content,
 			"ttl":     formatTTL(record.TTL),
 			"proxied": formatProxied(record.Proxied, record.Proxiable),
+			"comment": record.Comment,
+			"tags":    strings.Join(record.Tags, ","),
 			"id":      record.ID,
 		}
 	}
@@ -148,6 +213,9 @@ var cfDnsCreateCmd = &cobra.Command{
   - AAAA: IPv6 地址
   - CNAME: 别名记录
 
+创建前会结合域名的现有记录检查新记录（规则见 cf dns lint），
+只有 warning 时继续创建，发现 error 时中止，使用 --skip-lint 跳过检查。
+
 使用示例:
   # 创建 A 记录
   cloudctl cf dns create example.com -t A -n www --content 1.2.3.4
@@ -159,7 +227,10 @@ var cfDnsCreateCmd = &cobra.Command{
   cloudctl cf dns create example.com -t CNAME -n blog --content example.com
 
   # 创建记录并指定 TTL
-  cloudctl cf dns create example.com -t A -n api --content 1.2.3.4 --ttl 3600`,
+  cloudctl cf dns create example.com -t A -n api --content 1.2.3.4 --ttl 3600
+
+  # 创建记录并添加备注和标签
+  cloudctl cf dns create example.com -t A -n api --content 1.2.3.4 --comment "API 网关" --tag team:api,env:prod`,
 	Args: cobra.ExactArgs(1),
 	RunE: runDNSCreate,
 }
@@ -176,6 +247,9 @@ func runDNSCreate(cmd *cobra.Command, args []string) error {
 	content, _ := cmd.Flags().GetString("content")
 	ttl, _ := cmd.Flags().GetFloat64("ttl")
 	proxied, _ := cmd.Flags().GetBool("proxied")
+	comment, _ := cmd.Flags().GetString("comment")
+	tags, _ := cmd.Flags().GetStringSlice("tag")
+	skipLint, _ := cmd.Flags().GetBool("skip-lint")
 
 	// 验证记录类型
 	recordType = strings.ToUpper(recordType)
@@ -183,6 +257,12 @@ func runDNSCreate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("不支持的记录类型: %s (支持: A, AAAA, CNAME)", recordType)
 	}
 
+	for _, tag := range tags {
+		if err := cloudflare.ValidateTag(tag); err != nil {
+			return err
+		}
+	}
+
 	// 创建 Cloudflare 客户端
 	logger.Debug("创建 Cloudflare 客户端", "profile", profile)
 	client, err := cloudflare.NewClient(profile, logger.Logger)
@@ -202,6 +282,24 @@ func runDNSCreate(cmd *cobra.Command, args []string) error {
 		os.Exit(cloudflare.GetExitCode(err))
 	}
 
+	// 结合现有记录检查新记录
+	if !skipLint {
+		existing, err := client.ListDNSRecords(ctx, zone.ID, "")
+		if err != nil {
+			logger.Error("获取 DNS 记录失败", "error", err)
+			fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+			os.Exit(cloudflare.GetExitCode(err))
+		}
+
+		newRecords := cloudflare.RecordsFromConfig(zone.Name, []cloudflare.DNSRecordConfig{
+			{Type: recordType, Name: name, Content: content, TTL: ttl, Proxied: &proxied},
+		})
+		issues := cloudflare.LintNewRecords(zone.Name, existing, newRecords, cloudflare.LintOptions{})
+		if err := reportLintIssues(os.Stderr, issues); err != nil {
+			return err
+		}
+	}
+
 	// 创建 DNS 记录
 	logger.Info("正在创建 DNS 记录...", "type", recordType, "name", name)
 	record, err := client.CreateDNSRecord(ctx, zone.ID, cloudflare.DNSRecordCreateParams{
@@ -210,6 +308,8 @@ func runDNSCreate(cmd *cobra.Command, args []string) error {
 		Content: content,
 		TTL:     ttl,
 		Proxied: proxied,
+		Comment: comment,
+		Tags:    tags,
 	})
 	if err != nil {
 		logger.Error("创建 DNS 记录失败", "error", err)
@@ -228,6 +328,8 @@ func runDNSCreate(cmd *cobra.Command, args []string) error {
 		"content": record.Content,
 		"ttl":     formatTTL(record.TTL),
 		"proxied": formatProxied(record.Proxied, record.Proxiable),
+		"comment": record.Comment,
+		"tags":    strings.Join(record.Tags, ","),
 	}
 
 	if err := formatter.Format(data); err != nil {
@@ -241,19 +343,35 @@ func runDNSCreate(cmd *cobra.Command, args []string) error {
 
 // cfDnsUpdateCmd 更新 DNS 记录
 var cfDnsUpdateCmd = &cobra.Command{
-	Use:   "update <domain> <record-id>",
+	Use:   "update <domain> [record-id]",
 	Short: "更新 DNS 记录",
 	Long: `更新指定的 DNS 记录。
 
+可以通过 record-id 指定记录，也可以通过 --name 和 --type 选择记录:
+  - --name 支持相对名称 (www)、完整域名 (www.example.com.) 和 @ (根域名)
+  - 匹配到多条记录时报错，使用 --all 更新全部匹配的记录
+  - 部分记录更新失败时继续处理其他记录，输出每条记录的结果并返回非零退出码
+
 可以更新的字段:
   - content: 记录内容
   - ttl: TTL 值
   - proxied: 是否启用代理
+  - comment: 记录备注
+  - tag: 记录标签（替换现有的全部标签）
+
+修改 content、ttl 或 proxied 时会结合域名的其他记录检查更新后的记录（规则见 cf dns lint），
+发现 error 时中止，使用 --skip-lint 跳过检查。
 
 使用示例:
   # 更新记录内容
   cloudctl cf dns update example.com abc123 --content 2.3.4.5
 
+  # 按名称和类型更新
+  cloudctl cf dns update example.com --name www --type A --content 2.3.4.5
+
+  # 更新根域名的所有 A 记录
+  cloudctl cf dns update example.com --name @ --type A --ttl 300 --all
+
   # 更新 TTL
   cloudctl cf dns update example.com abc123 --ttl 3600
 
@@ -264,8 +382,11 @@ var cfDnsUpdateCmd = &cobra.Command{
   cloudctl cf dns update example.com abc123 --no-proxied
 
   # 同时更新多个字段
-  cloudctl cf dns update example.com abc123 --content 2.3.4.5 --ttl 3600 --proxied`,
-	Args: cobra.ExactArgs(2),
+  cloudctl cf dns update example.com abc123 --content 2.3.4.5 --ttl 3600 --proxied
+
+  # 更新备注和标签
+  cloudctl cf dns update example.com --name www --type A --comment "官网" --tag team:web`,
+	Args: cobra.RangeArgs(1, 2),
 	RunE: runDNSUpdate,
 }
 
@@ -273,38 +394,10 @@ var cfDnsUpdateCmd = &cobra.Command{
 func runDNSUpdate(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 	domain := args[0]
-	recordID := args[1]
 
 	// 获取参数
 	profile, _ := cmd.Flags().GetString("profile")
 
-	// 创建 Cloudflare 客户端
-	logger.Debug("创建 Cloudflare 客户端", "profile", profile)
-	client, err := cloudflare.NewClient(profile, logger.Logger)
-	if err != nil {
-		logger.Error("创建客户端失败", "error", err)
-		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
-		os.Exit(cloudflare.GetExitCode(err))
-	}
-	defer client.Close()
-
-	// 获取 Zone ID
-	logger.Info("正在查找域名...", "domain", domain)
-	zone, err := client.GetZoneByName(ctx, domain)
-	if err != nil {
-		logger.Error("查找域名失败", "error", err)
-		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
-		os.Exit(cloudflare.GetExitCode(err))
-	}
-
-	// 获取现有记录以确定类型
-	existingRecord, err := client.GetDNSRecord(ctx, zone.ID, recordID)
-	if err != nil {
-		logger.Error("获取 DNS 记录失败", "error", err)
-		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
-		os.Exit(cloudflare.GetExitCode(err))
-	}
-
 	// 构建更新参数
 	params := cloudflare.DNSRecordUpdateParams{}
 
@@ -335,52 +428,174 @@ func runDNSUpdate(cmd *cobra.Command, args []string) error {
 		hasUpdates = true
 	}
 
+	if cmd.Flags().Changed("comment") {
+		comment, _ := cmd.Flags().GetString("comment")
+		params.Comment = &comment
+		hasUpdates = true
+	}
+
+	if cmd.Flags().Changed("tag") {
+		tags, _ := cmd.Flags().GetStringSlice("tag")
+		for _, tag := range tags {
+			if err := cloudflare.ValidateTag(tag); err != nil {
+				return err
+			}
+		}
+		params.Tags = &tags
+		hasUpdates = true
+	}
+
 	if !hasUpdates {
-		return fmt.Errorf("请至少指定一个要更新的字段 (--content, --ttl, --proxied, --no-proxied)")
+		return fmt.Errorf("请至少指定一个要更新的字段 (--content, --ttl, --proxied, --no-proxied, --comment, --tag)")
+	}
+
+	selector, all, err := dnsRecordSelector(cmd, args)
+	if err != nil {
+		return err
 	}
 
-	// 更新 DNS 记录
-	logger.Info("正在更新 DNS 记录...", "record_id", recordID)
-	record, err := client.UpdateDNSRecord(ctx, zone.ID, recordID, existingRecord.Type, params)
+	// 创建 Cloudflare 客户端
+	logger.Debug("创建 Cloudflare 客户端", "profile", profile)
+	client, err := cloudflare.NewClient(profile, logger.Logger)
 	if err != nil {
-		logger.Error("更新 DNS 记录失败", "error", err)
+		logger.Error("创建客户端失败", "error", err)
 		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
 		os.Exit(cloudflare.GetExitCode(err))
 	}
+	defer client.Close()
 
-	// 获取格式化器
-	formatter := GetFormatter()
+	// 获取 Zone ID
+	logger.Info("正在查找域名...", "domain", domain)
+	zone, err := client.GetZoneByName(ctx, domain)
+	if err != nil {
+		logger.Error("查找域名失败", "error", err)
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
+	}
 
-	// 输出结果
-	data := map[string]interface{}{
-		"id":      record.ID,
-		"type":    record.Type,
-		"name":    record.Name,
-		"content": record.Content,
-		"ttl":     formatTTL(record.TTL),
-		"proxied": formatProxied(record.Proxied, record.Proxiable),
+	// 获取现有记录以确定类型
+	existingRecords, err := findDNSRecords(ctx, client, zone, args, selector, all)
+	if err != nil {
+		logger.Error("获取 DNS 记录失败", "error", err)
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
 	}
 
-	if err := formatter.Format(data); err != nil {
+	// 结合其他记录检查更新后的记录，只修改备注或标签时不检查
+	skipLint, _ := cmd.Flags().GetBool("skip-lint")
+	if !skipLint && (params.Content != nil || params.TTL != nil || params.Proxied != nil) {
+		zoneRecords, err := client.ListDNSRecords(ctx, zone.ID, "")
+		if err != nil {
+			logger.Error("获取 DNS 记录失败", "error", err)
+			fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+			os.Exit(cloudflare.GetExitCode(err))
+		}
+
+		others, updated := updatedDNSRecords(zoneRecords, existingRecords, params)
+		issues := cloudflare.LintNewRecords(zone.Name, others, updated, cloudflare.LintOptions{})
+		if err := reportLintIssues(os.Stderr, issues); err != nil {
+			return err
+		}
+	}
+
+	// 更新 DNS 记录，多条记录时单条记录失败继续更新其他记录
+	failed := 0
+	var lastErr error
+	data := make([]map[string]interface{}, 0, len(existingRecords))
+	for _, existingRecord := range existingRecords {
+		logger.Info("正在更新 DNS 记录...", "record_id", existingRecord.ID, "name", existingRecord.Name)
+		record, err := client.UpdateDNSRecord(ctx, zone.ID, existingRecord.ID, existingRecord.Type, params)
+		if err != nil {
+			logger.Error("更新 DNS 记录失败", "record_id", existingRecord.ID, "error", err)
+			if len(existingRecords) == 1 {
+				fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+				os.Exit(cloudflare.GetExitCode(err))
+			}
+
+			failed++
+			lastErr = err
+			data = append(data, map[string]interface{}{
+				"status":  "failed",
+				"id":      existingRecord.ID,
+				"type":    existingRecord.Type,
+				"name":    existingRecord.Name,
+				"content": existingRecord.Content,
+				"ttl":     formatTTL(existingRecord.TTL),
+				"proxied": formatProxied(existingRecord.Proxied, existingRecord.Proxiable),
+				"error":   cloudflare.FormatError(err),
+			})
+			continue
+		}
+
+		data = append(data, map[string]interface{}{
+			"status":  "updated",
+			"id":      record.ID,
+			"type":    record.Type,
+			"name":    record.Name,
+			"content": record.Content,
+			"ttl":     formatTTL(record.TTL),
+			"proxied": formatProxied(record.Proxied, record.Proxiable),
+			"error":   "",
+		})
+		logger.Info("成功更新 DNS 记录", "record_id", record.ID)
+	}
+
+	// 输出结果
+	if err := formatDNSRecordRows(data); err != nil {
 		logger.Error("格式化输出失败", "error", err)
 		return fmt.Errorf("格式化输出失败: %w", err)
 	}
 
-	logger.Info("成功更新 DNS 记录", "record_id", record.ID)
-	return nil
+	return dnsRecordFailures("更新", len(existingRecords), failed, lastErr)
+}
+
+// updatedDNSRecords 返回更新后的记录和 zone 中未被更新的其他记录，用于检查
+func updatedDNSRecords(zoneRecords, records []cloudflare.DNSRecordInfo, params cloudflare.DNSRecordUpdateParams) (others, updated []cloudflare.DNSRecordInfo) {
+	ids := make(map[string]bool, len(records))
+	for _, record := range records {
+		ids[record.ID] = true
+		if params.Content != nil {
+			record.Content = *params.Content
+		}
+		if params.TTL != nil {
+			record.TTL = *params.TTL
+		}
+		if params.Proxied != nil {
+			record.Proxied = *params.Proxied
+		}
+		updated = append(updated, record)
+	}
+
+	for _, record := range zoneRecords {
+		if !ids[record.ID] {
+			others = append(others, record)
+		}
+	}
+	return others, updated
 }
 
 // cfDnsDeleteCmd 删除 DNS 记录
 var cfDnsDeleteCmd = &cobra.Command{
-	Use:   "delete <domain> <record-id>",
+	Use:   "delete <domain> [record-id]",
 	Short: "删除 DNS 记录",
 	Long: `删除指定的 DNS 记录。
 
+可以通过 record-id 指定记录，也可以通过 --name 和 --type 选择记录:
+  - --name 支持相对名称 (www)、完整域名 (www.example.com.) 和 @ (根域名)
+  - 匹配到多条记录时报错，使用 --all 删除全部匹配的记录
+  - 部分记录删除失败时继续处理其他记录，输出每条记录的结果并返回非零退出码
+
 注意: 此操作不可逆，请谨慎使用。
 
 使用示例:
-  cloudctl cf dns delete example.com abc123`,
-	Args: cobra.ExactArgs(2),
+  cloudctl cf dns delete example.com abc123
+
+  # 按名称和类型删除（脚本中使用 -y 跳过确认）
+  cloudctl cf dns delete example.com --name www --type CNAME -y
+
+  # 删除 old 的所有记录
+  cloudctl cf dns delete example.com --name old --all`,
+	Args: cobra.RangeArgs(1, 2),
 	RunE: runDNSDelete,
 }
 
@@ -388,10 +603,15 @@ var cfDnsDeleteCmd = &cobra.Command{
 func runDNSDelete(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 	domain := args[0]
-	recordID := args[1]
 
 	// 获取参数
 	profile, _ := cmd.Flags().GetString("profile")
+	yes, _ := cmd.Flags().GetBool("yes")
+
+	selector, all, err := dnsRecordSelector(cmd, args)
+	if err != nil {
+		return err
+	}
 
 	// 创建 Cloudflare 客户端
 	logger.Debug("创建 Cloudflare 客户端", "profile", profile)
@@ -413,7 +633,7 @@ func runDNSDelete(cmd *cobra.Command, args []string) error {
 	}
 
 	// 获取记录信息用于确认
-	record, err := client.GetDNSRecord(ctx, zone.ID, recordID)
+	records, err := findDNSRecords(ctx, client, zone, args, selector, all)
 	if err != nil {
 		logger.Error("获取 DNS 记录失败", "error", err)
 		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
@@ -421,47 +641,417 @@ func runDNSDelete(cmd *cobra.Command, args []string) error {
 	}
 
 	// 显示确认信息
-	fmt.Printf("确认删除以下 DNS 记录?\n")
-	fmt.Printf("  类型: %s\n", record.Type)
-	fmt.Printf("  名称: %s\n", record.Name)
-	fmt.Printf("  内容: %s\n", record.Content)
-	fmt.Printf("  ID: %s\n", record.ID)
-	fmt.Print("\n输入 'yes' 确认删除: ")
-
-	var confirm string
-	fmt.Scanln(&confirm)
-
-	if confirm != "yes" {
-		fmt.Println("已取消删除操作")
+	if !yes {
+		fmt.Printf("确认删除以下 %d 条 DNS 记录?\n", len(records))
+		for _, record := range records {
+			fmt.Printf("  类型: %s\n", record.Type)
+			fmt.Printf("  名称: %s\n", record.Name)
+			fmt.Printf("  内容: %s\n", record.Content)
+			fmt.Printf("  ID: %s\n\n", record.ID)
+		}
+		fmt.Print("输入 'yes' 确认删除: ")
+
+		var confirm string
+		fmt.Scanln(&confirm)
+
+		if confirm != "yes" {
+			fmt.Println("已取消删除操作")
+			return nil
+		}
+	}
+
+	// 删除 DNS 记录，多条记录时单条记录失败继续删除其他记录
+	failed := 0
+	var lastErr error
+	data := make([]map[string]interface{}, 0, len(records))
+	for _, record := range records {
+		logger.Info("正在删除 DNS 记录...", "record_id", record.ID, "name", record.Name)
+		row := map[string]interface{}{
+			"status":    "deleted",
+			"record_id": record.ID,
+			"type":      record.Type,
+			"name":      record.Name,
+			"error":     "",
+		}
+
+		if err := client.DeleteDNSRecord(ctx, zone.ID, record.ID); err != nil {
+			logger.Error("删除 DNS 记录失败", "record_id", record.ID, "error", err)
+			if len(records) == 1 {
+				fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+				os.Exit(cloudflare.GetExitCode(err))
+			}
+
+			failed++
+			lastErr = err
+			row["status"] = "failed"
+			row["error"] = cloudflare.FormatError(err)
+		} else {
+			logger.Info("成功删除 DNS 记录", "record_id", record.ID)
+		}
+		data = append(data, row)
+	}
+
+	// 输出结果
+	if err := formatDNSRecordRows(data); err != nil {
+		logger.Error("格式化输出失败", "error", err)
+		return fmt.Errorf("格式化输出失败: %w", err)
+	}
+
+	return dnsRecordFailures("删除", len(records), failed, lastErr)
+}
+
+// dnsRecordFailures 汇总多条记录操作的失败：全部失败时按最后一个错误的类型退出，
+// 保留认证、限流等错误的退出码；部分失败时返回一般错误
+func dnsRecordFailures(action string, total, failed int, lastErr error) error {
+	if failed == 0 {
 		return nil
 	}
+	if failed == total {
+		fmt.Fprintf(os.Stderr, "%d 条 DNS 记录全部%s失败: %s\n", total, action, cloudflare.FormatError(lastErr))
+		os.Exit(cloudflare.GetExitCode(lastErr))
+	}
+	return fmt.Errorf("%d 条 DNS 记录中有 %d 条%s失败", total, failed, action)
+}
+
+// dnsRecordSelector 从命令参数中解析记录选择器
+// 指定了 record-id 时不能同时使用 --name/--type/--all，未指定 record-id 时必须指定 --name
+func dnsRecordSelector(cmd *cobra.Command, args []string) (cloudflare.DNSRecordSelector, bool, error) {
+	name, _ := cmd.Flags().GetString("name")
+	recordType, _ := cmd.Flags().GetString("type")
+	all, _ := cmd.Flags().GetBool("all")
+
+	selector := cloudflare.DNSRecordSelector{Name: name, Type: recordType}
+	hasSelector := cmd.Flags().Changed("name") || cmd.Flags().Changed("type") || all
+
+	if len(args) == 2 {
+		if hasSelector {
+			return selector, false, fmt.Errorf("指定 record-id 时不能同时使用 --name、--type 或 --all")
+		}
+		return selector, false, nil
+	}
+
+	if !cmd.Flags().Changed("name") {
+		return selector, false, fmt.Errorf("请指定 record-id 或使用 --name 选择记录")
+	}
+
+	return selector, all, nil
+}
+
+// findDNSRecords 按 record-id 或选择器获取要操作的记录
+func findDNSRecords(ctx context.Context, client *cloudflare.Client, zone *cloudflare.ZoneInfo, args []string, selector cloudflare.DNSRecordSelector, all bool) ([]cloudflare.DNSRecordInfo, error) {
+	if len(args) == 2 {
+		record, err := client.GetDNSRecord(ctx, zone.ID, args[1])
+		if err != nil {
+			return nil, err
+		}
+		return []cloudflare.DNSRecordInfo{*record}, nil
+	}
 
-	// 删除 DNS 记录
-	logger.Info("正在删除 DNS 记录...", "record_id", recordID)
-	err = client.DeleteDNSRecord(ctx, zone.ID, recordID)
+	return client.FindDNSRecords(ctx, zone, selector, all)
+}
+
+// formatDNSRecordRows 输出记录操作结果，只有一条记录时按单条记录输出
+func formatDNSRecordRows(data []map[string]interface{}) error {
+	if len(data) == 1 {
+		return GetFormatter().Format(data[0])
+	}
+	return GetFormatter().Format(data)
+}
+
+// cfDnsSearchCmd 跨 Zone 搜索 DNS 记录
+var cfDnsSearchCmd = &cobra.Command{
+	Use:   "search",
+	Short: "跨域名搜索 DNS 记录",
+	Long: `在所有域名（或 --zones 指定的域名）中并发搜索 DNS 记录，
+常用于查找指向某个 ELB、IP 或 CloudFront 域名的记录。
+
+匹配规则:
+  - 默认按通配符匹配完整的值，* 匹配任意字符，? 匹配单个字符
+  - 使用 --regex 时按正则表达式匹配，只需匹配部分内容
+  - 均不区分大小写；同时指定 --name 和 --content 时需同时满足
+
+部分域名查询失败时仍输出已找到的记录，命令返回非零退出码。
+
+使用示例:
+  # 查找指向某个 ELB 的记录
+  cloudctl cf dns search --content '*.elb.amazonaws.com'
+
+  # 查找指向某个 IP 的 A 记录
+  cloudctl cf dns search --content 1.2.3.4 --type A
+
+  # 按名称搜索
+  cloudctl cf dns search --name 'api.*'
+
+  # 使用正则表达式，只在指定域名中搜索
+  cloudctl cf dns search --content 'd[0-9a-z]+\.cloudfront\.net' --regex --zones example.com,test.com`,
+	Args: cobra.NoArgs,
+	RunE: runDNSSearch,
+}
+
+// runDNSSearch 执行 dns search 命令
+func runDNSSearch(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// 获取参数
+	profile, _ := cmd.Flags().GetString("profile")
+	opts := cloudflare.DNSSearchOptions{}
+	opts.Name, _ = cmd.Flags().GetString("name")
+	opts.Content, _ = cmd.Flags().GetString("content")
+	opts.Type, _ = cmd.Flags().GetString("type")
+	opts.Regex, _ = cmd.Flags().GetBool("regex")
+	opts.Zones, _ = cmd.Flags().GetStringSlice("zones")
+	opts.Concurrency, _ = cmd.Flags().GetInt("concurrency")
+
+	// 验证并发数
+	if opts.Concurrency < 1 {
+		opts.Concurrency = 1
+	}
+	if opts.Concurrency > 10 {
+		opts.Concurrency = 10
+	}
+
+	// 提前验证匹配模式，避免无效参数时请求 API
+	if _, err := cloudflare.NewDNSRecordMatcher(opts.Name, opts.Content, opts.Regex); err != nil {
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
+	}
+
+	// 创建 Cloudflare 客户端
+	logger.Debug("创建 Cloudflare 客户端", "profile", profile)
+	client, err := cloudflare.NewClient(profile, logger.Logger)
 	if err != nil {
-		logger.Error("删除 DNS 记录失败", "error", err)
+		logger.Error("创建客户端失败", "error", err)
 		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
 		os.Exit(cloudflare.GetExitCode(err))
 	}
+	defer client.Close()
 
-	// 获取格式化器
-	formatter := GetFormatter()
+	// 进度回调
+	progressCallback := func(msg string) {
+		logger.Debug(msg)
+	}
 
-	// 输出结果
-	data := map[string]interface{}{
-		"status":    "deleted",
-		"record_id": recordID,
-		"type":      record.Type,
-		"name":      record.Name,
+	result, err := client.SearchDNSRecords(ctx, opts, progressCallback)
+	if err != nil {
+		logger.Error("搜索 DNS 记录失败", "error", err)
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
 	}
 
-	if err := formatter.Format(data); err != nil {
+	for zone, zoneErr := range result.ZoneErrors {
+		logger.Warn("搜索域名失败", "zone", zone, "error", zoneErr)
+	}
+
+	if len(result.Matches) == 0 {
+		logger.Info("未找到匹配的 DNS 记录", "zones", result.SearchedZones)
+		fmt.Println("未找到匹配的 DNS 记录")
+	} else {
+		data := make([]map[string]interface{}, 0, len(result.Matches))
+		for _, match := range result.Matches {
+			data = append(data, map[string]interface{}{
+				"zone":    match.Zone,
+				"name":    match.Record.Name,
+				"type":    match.Record.Type,
+				"content": match.Record.Content,
+				"ttl":     formatTTL(match.Record.TTL),
+				"proxied": formatProxied(match.Record.Proxied, match.Record.Proxiable),
+				"id":      match.Record.ID,
+			})
+		}
+
+		if err := GetFormatter().Format(data); err != nil {
+			logger.Error("格式化输出失败", "error", err)
+			return fmt.Errorf("格式化输出失败: %w", err)
+		}
+
+		logger.Info("搜索完成", "zones", result.SearchedZones, "matches", len(result.Matches))
+	}
+
+	if len(result.ZoneErrors) > 0 {
+		return fmt.Errorf("有 %d 个域名搜索失败", len(result.ZoneErrors))
+	}
+
+	return nil
+}
+
+// cfDnsReplaceCmd 跨 Zone 批量替换记录内容
+var cfDnsReplaceCmd = &cobra.Command{
+	Use:   "replace",
+	Short: "跨域名批量替换 DNS 记录内容",
+	Long: `查找所有内容为 --from 的 DNS 记录（完全匹配，不区分大小写），
+按域名分组显示替换计划，并将记录内容替换为 --to。
+
+只替换 A、AAAA 和 CNAME 记录，其他类型的匹配记录在计划中标记为跳过。
+默认只显示计划，使用 --apply 执行替换。替换并发执行，
+每条记录更新成功后立即写入回滚日志 (JSON Lines)，包含 zone_id、record_id 和原内容，
+可用于恢复记录。
+
+使用示例:
+  # 预览替换计划
+  cloudctl cf dns replace --from 1.2.3.4 --to 5.6.7.8
+
+  # 只替换指定域名中的 A 记录并执行
+  cloudctl cf dns replace --from 1.2.3.4 --to 5.6.7.8 --type A --zones example.com,test.com --apply
+
+  # 替换 CNAME 指向的负载均衡器，指定回滚日志路径
+  cloudctl cf dns replace --from old-elb.us-east-1.elb.amazonaws.com --to new-elb.us-east-1.elb.amazonaws.com \
+    --apply --rollback-log elb-migration.jsonl`,
+	Args: cobra.NoArgs,
+	RunE: runDNSReplace,
+}
+
+// runDNSReplace 执行 dns replace 命令
+func runDNSReplace(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// 获取参数
+	profile, _ := cmd.Flags().GetString("profile")
+	from, _ := cmd.Flags().GetString("from")
+	to, _ := cmd.Flags().GetString("to")
+	recordType, _ := cmd.Flags().GetString("type")
+	zones, _ := cmd.Flags().GetStringSlice("zones")
+	apply, _ := cmd.Flags().GetBool("apply")
+	concurrency, _ := cmd.Flags().GetInt("concurrency")
+	rollbackFile, _ := cmd.Flags().GetString("rollback-log")
+
+	from = strings.TrimSpace(from)
+	to = strings.TrimSpace(to)
+	if from == "" || to == "" {
+		return fmt.Errorf("--from 和 --to 不能为空")
+	}
+	if strings.EqualFold(from, to) {
+		return fmt.Errorf("--from 和 --to 相同，无需替换")
+	}
+
+	// 验证并发数
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	if concurrency > 10 {
+		concurrency = 10
+	}
+
+	// 创建 Cloudflare 客户端
+	logger.Debug("创建 Cloudflare 客户端", "profile", profile)
+	client, err := cloudflare.NewClient(profile, logger.Logger)
+	if err != nil {
+		logger.Error("创建客户端失败", "error", err)
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
+	}
+	defer client.Close()
+
+	// 进度回调
+	progressCallback := func(msg string) {
+		logger.Debug(msg)
+	}
+
+	logger.Info("正在查找匹配的 DNS 记录...", "from", from)
+	search, err := client.FindDNSRecordsByContent(ctx, from, recordType, zones, concurrency, progressCallback)
+	if err != nil {
+		logger.Error("查找 DNS 记录失败", "error", err)
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
+	}
+
+	// 部分域名查询失败时不执行替换，避免遗漏记录
+	if len(search.ZoneErrors) > 0 {
+		for zone, zoneErr := range search.ZoneErrors {
+			fmt.Fprintf(os.Stderr, "✗ %s: %s\n", zone, cloudflare.FormatError(zoneErr))
+		}
+		return fmt.Errorf("有 %d 个域名查询失败，未执行替换", len(search.ZoneErrors))
+	}
+
+	if len(search.Matches) == 0 {
+		fmt.Printf("在 %d 个域名中未找到内容为 %s 的记录\n", search.SearchedZones, from)
+		return nil
+	}
+
+	// 记录类型不支持更新的记录只显示在计划中，不执行替换
+	replaceable, unsupported := cloudflare.SplitReplaceableMatches(search.Matches)
+	skipped := make(map[string]bool, len(unsupported))
+	for _, match := range unsupported {
+		skipped[match.ZoneID+"/"+match.Record.ID] = true
+	}
+
+	// 显示替换计划
+	order, groups := cloudflare.GroupMatchesByZone(search.Matches)
+	fmt.Printf("=== 替换计划: %s -> %s ===\n", from, to)
+	fmt.Printf("共 %d 个域名，%d 条记录", len(order), len(search.Matches))
+	if len(unsupported) > 0 {
+		fmt.Printf("（%d 条记录的类型不支持更新，将跳过）", len(unsupported))
+	}
+	fmt.Println()
+	for _, zone := range order {
+		fmt.Printf("\nZone: %s (%d 条记录)\n", zone, len(groups[zone]))
+		for _, match := range groups[zone] {
+			note := ""
+			if skipped[match.ZoneID+"/"+match.Record.ID] {
+				note = fmt.Sprintf(" [跳过: 不支持更新 %s 记录]", strings.ToUpper(match.Record.Type))
+			}
+			fmt.Printf("  %s %s: %s -> %s (ID: %s)%s\n",
+				match.Record.Type, match.Record.Name, match.Record.Content, to, match.Record.ID, note)
+		}
+	}
+	fmt.Println()
+
+	if len(replaceable) == 0 {
+		fmt.Println("没有可以替换的记录（支持: A, AAAA, CNAME）")
+		return nil
+	}
+
+	if !apply {
+		fmt.Println("预览模式，使用 --apply 执行替换")
+		return nil
+	}
+
+	// 打开回滚日志
+	if rollbackFile == "" {
+		rollbackFile = fmt.Sprintf("dns-replace-%s.jsonl", time.Now().Format("20060102-150405"))
+	}
+	rollbackLog, err := cloudflare.NewRollbackLog(rollbackFile)
+	if err != nil {
+		logger.Error("创建回滚日志失败", "error", err)
+		return err
+	}
+	defer rollbackLog.Close()
+	logger.Info("回滚日志", "file", rollbackFile)
+
+	results := client.ReplaceDNSRecordContent(ctx, replaceable, to, concurrency, rollbackLog, progressCallback)
+
+	failed := 0
+	data := make([]map[string]interface{}, 0, len(results))
+	for _, result := range results {
+		row := map[string]interface{}{
+			"zone":        result.Zone,
+			"name":        result.Name,
+			"type":        result.Type,
+			"old_content": result.OldContent,
+			"new_content": result.NewContent,
+			"id":          result.RecordID,
+			"status":      "updated",
+			"error":       "",
+		}
+		if !result.Success {
+			failed++
+			row["status"] = "failed"
+			row["error"] = cloudflare.FormatError(result.Error)
+		}
+		data = append(data, row)
+	}
+
+	if err := GetFormatter().Format(data); err != nil {
 		logger.Error("格式化输出失败", "error", err)
 		return fmt.Errorf("格式化输出失败: %w", err)
 	}
 
-	logger.Info("成功删除 DNS 记录", "record_id", recordID)
+	fmt.Printf("\n回滚日志已写入: %s\n", rollbackFile)
+
+	if failed > 0 {
+		return fmt.Errorf("有 %d 条记录替换失败", failed)
+	}
+
+	logger.Info("替换完成", "records", len(results))
 	return nil
 }
 
@@ -493,7 +1083,27 @@ var cfDnsBatchCreateCmd = &cobra.Command{
 支持多个 zone，每个 zone 可以包含多个记录。
 失败时会继续执行其他项，最后汇总结果。
 
+记录已存在时的处理策略 (--on-conflict 或配置文件中的 on_conflict):
+  - fail    视为失败（默认）
+  - skip    已存在完全相同的记录时视为成功并跳过，内容不同时视为失败
+  - update  已存在的记录与配置不同时更新 content、ttl、proxied、备注和标签
+
+skip 和 update 策略在创建前按名称和类型匹配现有记录（包括允许多条同名的 A/AAAA 记录），
+与配置中其他记录内容相同的现有记录不会被匹配；有多条内容不同的同名记录时视为冲突。
+
+配置了 owner_tag 时，没有所有权标签的相同记录不视为相同：skip 策略报告冲突，
+update 策略为其添加所有权标签。
+
+记录可以配置 comment 和 tags。配置 owner_tag（或 --owner-tag）后，创建的记录会自动添加
+该所有权标签；on_conflict: update 只更新带有该标签的记录，batch-update 和 batch-delete
+也只操作带有该标签的记录，避免修改其他团队或工具管理的记录。
+
+创建前会结合各 zone 的现有记录检查配置中的记录（例如与现有 CNAME 同名），
+发现 error 时中止，使用 --skip-lint 跳过检查。无法获取现有记录的 zone 只检查配置本身。
+
 配置文件示例 (dns-records.yaml):
+  on_conflict: skip
+  owner_tag: managed-by:cloudctl
   zones:
     - zone: example1.com
       records:
@@ -502,6 +1112,8 @@ var cfDnsBatchCreateCmd = &cobra.Command{
           content: 1.2.3.4
           ttl: 3600
           proxied: true
+          comment: 官网
+          tags: [team:web]
         - type: CNAME
           name: blog
           content: example1.com
@@ -525,147 +1137,340 @@ var cfDnsBatchCreateCmd = &cobra.Command{
   # 使用并发加速（最多 10 个并发）
   cloudctl cf dns batch-create --config dns-records.yaml --concurrency 3
 
+  # 重复执行时更新已存在但不同的记录
+  cloudctl cf dns batch-create --config dns-records.yaml --on-conflict update
+
+  # 跳过创建前的记录检查（规则见 cf dns lint）
+  cloudctl cf dns batch-create --config dns-records.yaml --skip-lint
+
   # 查看详细日志
   cloudctl cf dns batch-create --config dns-records.yaml -vv`,
 	Args: cobra.NoArgs,
-	RunE: runDNSBatchCreate,
+	RunE: runDNSBatch(cloudflare.DNSBatchCreate),
 }
 
-// runDNSBatchCreate 执行批量创建命令
-func runDNSBatchCreate(cmd *cobra.Command, args []string) error {
-	ctx := context.Background()
+// cfDnsBatchUpdateCmd 批量更新 DNS 记录
+var cfDnsBatchUpdateCmd = &cobra.Command{
+	Use:   "batch-update",
+	Short: "批量更新 DNS 记录",
+	Long: `通过 YAML 配置文件批量更新 DNS 记录，配置格式与 batch-create 相同。
 
-	// 获取参数
-	profile, _ := cmd.Flags().GetString("profile")
-	configFile, _ := cmd.Flags().GetString("config")
-	dryRun, _ := cmd.Flags().GetBool("dry-run")
-	concurrency, _ := cmd.Flags().GetInt("concurrency")
+记录按 name 和 type 匹配现有记录（name 支持相对名称、完整域名和 @），
+并更新为配置中的 content、ttl 和 proxied；未配置 ttl 或 proxied 时保留现有值。
+配置的 comment 会替换现有备注，tags 会合并到现有标签。
+配置了 owner_tag（或 --owner-tag）时只更新带有该所有权标签的记录。
+同名同类型有多条记录时，使用 match_content 指定要更新的记录，
+否则该记录报错并跳过。失败时会继续执行其他项，最后汇总结果。
 
-	// 验证并发数
-	if concurrency < 1 {
-		concurrency = 1
-	}
-	if concurrency > 10 {
-		concurrency = 10
-	}
+更新前会结合各 zone 的现有记录检查更新后的记录（规则见 cf dns lint），
+发现 error 时中止，使用 --skip-lint 跳过检查。
 
-	// 加载配置文件
-	logger.Info("加载批量操作配置文件", "file", configFile)
-	config, err := cloudflare.LoadDNSBatchConfig(configFile)
-	if err != nil {
-		logger.Error("加载配置文件失败", "error", err)
-		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
-		os.Exit(cloudflare.GetExitCode(err))
-	}
+配置文件示例:
+  zones:
+    - zone: example.com
+      records:
+        - type: A
+          name: www
+          content: 2.3.4.5
+          proxied: true
+        - type: A
+          name: api
+          match_content: 1.2.3.4   # 只更新内容为 1.2.3.4 的记录
+          content: 5.6.7.8
+
+使用示例:
+  # 预览模式（不实际执行）
+  cloudctl cf dns batch-update --config dns-update.yaml --dry-run
 
-	logger.Info("配置文件加载成功",
-		"zones", len(config.Zones),
-		"total_records", countTotalRecords(config),
-	)
+  # 使用并发加速（最多 10 个并发）
+  cloudctl cf dns batch-update --config dns-update.yaml --concurrency 3`,
+	Args: cobra.NoArgs,
+	RunE: runDNSBatch(cloudflare.DNSBatchUpdate),
+}
 
-	// 预览模式
-	if dryRun {
-		fmt.Println("=== 预览模式 ===")
-		fmt.Printf("总共 %d 个 zone，%d 条记录\n\n", len(config.Zones), countTotalRecords(config))
+// cfDnsBatchDeleteCmd 批量删除 DNS 记录
+var cfDnsBatchDeleteCmd = &cobra.Command{
+	Use:   "batch-delete",
+	Short: "批量删除 DNS 记录",
+	Long: `通过 YAML 配置文件批量删除 DNS 记录，配置格式与 batch-create 相同。
 
-		for i, zone := range config.Zones {
-			fmt.Printf("Zone %d: %s (%d 条记录)\n", i+1, zone.Zone, len(zone.Records))
-			for j, record := range zone.Records {
-				fmt.Printf("  %d. %s %s -> %s", j+1, record.Type, record.Name, record.Content)
-				if record.TTL > 0 && record.TTL != 1 {
-					fmt.Printf(" (TTL: %.0f)", record.TTL)
-				}
-				if record.Proxied {
-					fmt.Print(" [Proxied]")
+记录按 name 和 type 匹配现有记录，content 可以省略；
+配置了 match_content 或 content 时只删除内容相同的记录。
+同名同类型有多条记录且未指定内容时，该记录报错并跳过。
+配置了 owner_tag（或 --owner-tag）时只删除带有该所有权标签的记录。
+
+注意: 此操作不可逆，执行前会要求确认，使用 -y 跳过确认。
+
+配置文件示例:
+  zones:
+    - zone: example.com
+      records:
+        - type: CNAME
+          name: old-blog
+        - type: A
+          name: www
+          content: 1.2.3.4
+
+使用示例:
+  # 预览模式（不实际执行）
+  cloudctl cf dns batch-delete --config dns-delete.yaml --dry-run
+
+  # 跳过确认
+  cloudctl cf dns batch-delete --config dns-delete.yaml -y`,
+	Args: cobra.NoArgs,
+	RunE: runDNSBatch(cloudflare.DNSBatchDelete),
+}
+
+// runDNSBatch 返回执行指定批量操作的命令函数
+func runDNSBatch(op cloudflare.DNSBatchOperation) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		label := op.Label()
+
+		// 获取参数
+		profile, _ := cmd.Flags().GetString("profile")
+		configFile, _ := cmd.Flags().GetString("config")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		concurrency, _ := cmd.Flags().GetInt("concurrency")
+
+		// 验证并发数
+		if concurrency < 1 {
+			concurrency = 1
+		}
+		if concurrency > 10 {
+			concurrency = 10
+		}
+
+		// 加载配置文件
+		logger.Info("加载批量操作配置文件", "file", configFile)
+		config, err := cloudflare.LoadDNSBatchConfigFor(configFile, op)
+		if err != nil {
+			logger.Error("加载配置文件失败", "error", err)
+			fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+			os.Exit(cloudflare.GetExitCode(err))
+		}
+
+		// 命令行参数覆盖配置文件中的冲突处理策略
+		if op == cloudflare.DNSBatchCreate && cmd.Flags().Changed("on-conflict") {
+			onConflict, _ := cmd.Flags().GetString("on-conflict")
+			policy, err := cloudflare.ParseConflictPolicy(onConflict)
+			if err != nil {
+				return err
+			}
+			config.OnConflict = policy
+		}
+
+		// 命令行参数覆盖配置文件中的所有权标签
+		if cmd.Flags().Changed("owner-tag") {
+			ownerTag, _ := cmd.Flags().GetString("owner-tag")
+			if ownerTag != "" {
+				if err := cloudflare.ValidateTag(ownerTag); err != nil {
+					return err
 				}
-				fmt.Println()
 			}
-			fmt.Println()
+			config.OwnerTag = ownerTag
 		}
 
-		fmt.Println("使用 --dry-run=false 执行实际创建")
-		return nil
-	}
+		logger.Info("配置文件加载成功",
+			"zones", len(config.Zones),
+			"total_records", countTotalRecords(config),
+		)
+
+		// 创建或更新前结合现有记录检查配置中的记录，无法连接 Cloudflare 时只检查配置本身，
+		// 不影响预览；实际执行时再报告客户端错误
+		var client *cloudflare.Client
+		if op == cloudflare.DNSBatchCreate || op == cloudflare.DNSBatchUpdate {
+			skipLint, _ := cmd.Flags().GetBool("skip-lint")
+			if !skipLint {
+				var issues []cloudflare.LintIssue
+				logger.Debug("创建 Cloudflare 客户端", "profile", profile)
+				client, err = cloudflare.NewClient(profile, logger.Logger)
+				if err != nil {
+					logger.Warn("创建客户端失败，只检查配置中的记录", "error", err)
+					fmt.Fprintf(os.Stderr, "⚠ 无法获取现有记录，只检查配置中的记录: %v\n", err)
+					client = nil
+					issues = lintDNSBatchConfig(config, cloudflare.LintOptions{})
+				} else {
+					defer client.Close()
+					issues = lintDNSBatchConfigWithExisting(ctx, os.Stderr, client, config, op, cloudflare.LintOptions{})
+				}
+				if err := reportLintIssues(os.Stderr, issues); err != nil {
+					return err
+				}
+			}
+		}
 
-	// 创建 Cloudflare 客户端
-	logger.Debug("创建 Cloudflare 客户端", "profile", profile)
-	client, err := cloudflare.NewClient(profile, logger.Logger)
-	if err != nil {
-		logger.Error("创建客户端失败", "error", err)
-		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
-		os.Exit(cloudflare.GetExitCode(err))
-	}
-	defer client.Close()
+		// 预览模式
+		if dryRun {
+			printDNSBatchPreview(op, config)
+			fmt.Printf("使用 --dry-run=false 执行实际%s\n", label)
+			return nil
+		}
 
-	// 进度回调
-	progressCallback := func(msg string) {
-		logger.Info(msg)
-	}
+		// 删除前确认
+		if op == cloudflare.DNSBatchDelete {
+			yes, _ := cmd.Flags().GetBool("yes")
+			if !yes {
+				printDNSBatchPreview(op, config)
+				fmt.Print("输入 'yes' 确认删除: ")
 
-	// 执行批量创建
-	var result *cloudflare.DNSBatchResult
-	if concurrency > 1 {
-		logger.Info("开始并发批量创建", "concurrency", concurrency)
-		result, err = client.BatchCreateDNSRecordsConcurrent(ctx, config, concurrency, progressCallback)
-	} else {
-		logger.Info("开始批量创建")
-		result, err = client.BatchCreateDNSRecords(ctx, config, progressCallback)
-	}
+				var confirm string
+				fmt.Scanln(&confirm)
 
-	if err != nil {
-		logger.Error("批量创建失败", "error", err)
-		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
-		os.Exit(cloudflare.GetExitCode(err))
-	}
+				if confirm != "yes" {
+					fmt.Println("已取消删除操作")
+					return nil
+				}
+			}
+		}
 
-	// 输出结果汇总
-	fmt.Println("\n=== 批量创建结果 ===")
-	fmt.Printf("总耗时: %s\n\n", result.Duration.Round(time.Millisecond))
+		// 创建 Cloudflare 客户端
+		if client == nil {
+			logger.Debug("创建 Cloudflare 客户端", "profile", profile)
+			client, err = cloudflare.NewClient(profile, logger.Logger)
+			if err != nil {
+				logger.Error("创建客户端失败", "error", err)
+				fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+				os.Exit(cloudflare.GetExitCode(err))
+			}
+			defer client.Close()
+		}
 
-	fmt.Printf("Zone 统计:\n")
-	fmt.Printf("  总数: %d\n", result.TotalZones)
-	fmt.Printf("  成功: %d\n", result.SuccessZones)
-	fmt.Printf("  失败: %d\n\n", result.FailedZones)
+		// 进度回调
+		progressCallback := func(msg string) {
+			logger.Info(msg)
+		}
 
-	fmt.Printf("记录统计:\n")
-	fmt.Printf("  总数: %d\n", result.TotalRecords)
-	fmt.Printf("  成功: %d\n", result.SuccessRecords)
-	fmt.Printf("  失败: %d\n\n", result.FailedRecords)
+		// 执行批量操作
+		var result *cloudflare.DNSBatchResult
+		switch {
+		case op == cloudflare.DNSBatchUpdate:
+			logger.Info("开始批量更新", "concurrency", concurrency)
+			result, err = client.BatchUpdateDNSRecords(ctx, config, concurrency, progressCallback)
+		case op == cloudflare.DNSBatchDelete:
+			logger.Info("开始批量删除", "concurrency", concurrency)
+			result, err = client.BatchDeleteDNSRecords(ctx, config, concurrency, progressCallback)
+		case concurrency > 1:
+			logger.Info("开始并发批量创建", "concurrency", concurrency)
+			result, err = client.BatchCreateDNSRecordsConcurrent(ctx, config, concurrency, progressCallback)
+		default:
+			logger.Info("开始批量创建")
+			result, err = client.BatchCreateDNSRecords(ctx, config, progressCallback)
+		}
 
-	// 详细结果
-	fmt.Println("详细结果:")
-	for i, zoneResult := range result.ZoneResults {
-		status := "✓"
-		if !zoneResult.Success {
-			status = "✗"
+		if err != nil {
+			logger.Error("批量"+label+"失败", "error", err)
+			fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+			os.Exit(cloudflare.GetExitCode(err))
 		}
 
-		fmt.Printf("\n%s Zone %d: %s\n", status, i+1, zoneResult.Zone)
+		// 输出结果汇总
+		fmt.Printf("\n=== 批量%s结果 ===\n", label)
+		fmt.Printf("总耗时: %s\n\n", result.Duration.Round(time.Millisecond))
+
+		fmt.Printf("Zone 统计:\n")
+		fmt.Printf("  总数: %d\n", result.TotalZones)
+		fmt.Printf("  成功: %d\n", result.SuccessZones)
+		fmt.Printf("  失败: %d\n\n", result.FailedZones)
+
+		fmt.Printf("记录统计:\n")
+		fmt.Printf("  总数: %d\n", result.TotalRecords)
+		fmt.Printf("  成功: %d\n", result.SuccessRecords)
+		fmt.Printf("  失败: %d\n\n", result.FailedRecords)
+
+		// 详细结果
+		fmt.Println("详细结果:")
+		for i, zoneResult := range result.ZoneResults {
+			status := "✓"
+			if !zoneResult.Success {
+				status = "✗"
+			}
 
-		if zoneResult.Error != nil {
-			fmt.Printf("  错误: %v\n", zoneResult.Error)
-			continue
-		}
+			fmt.Printf("\n%s Zone %d: %s\n", status, i+1, zoneResult.Zone)
 
-		fmt.Printf("  记录: %d 成功, %d 失败\n", zoneResult.SuccessRecords, zoneResult.FailedRecords)
+			if zoneResult.Error != nil {
+				fmt.Printf("  错误: %v\n", zoneResult.Error)
+				continue
+			}
 
-		// 显示失败的记录
-		for _, recordResult := range zoneResult.RecordResults {
-			if !recordResult.Success {
-				fmt.Printf("    ✗ %s %s -> %s: %v\n",
-					recordResult.Type, recordResult.Name, recordResult.Content, recordResult.Error)
+			fmt.Printf("  记录: %d 成功, %d 失败\n", zoneResult.SuccessRecords, zoneResult.FailedRecords)
+
+			// 显示失败的记录，以及创建时跳过或更新的已有记录
+			for _, recordResult := range zoneResult.RecordResults {
+				switch {
+				case !recordResult.Success:
+					fmt.Printf("    ✗ %s %s -> %s: %v\n",
+						recordResult.Type, recordResult.Name, recordResult.Content, recordResult.Error)
+				case op == cloudflare.DNSBatchCreate && recordResult.Action != cloudflare.RecordActionCreated:
+					fmt.Printf("    - %s %s -> %s: %s\n",
+						recordResult.Type, recordResult.Name, recordResult.Content, recordResult.Action)
+				}
 			}
 		}
-	}
 
-	// 如果有失败，返回错误
-	if result.FailedRecords > 0 || result.FailedZones > 0 {
-		fmt.Println("\n部分操作失败，请检查上述错误信息")
-		os.Exit(1)
+		// 如果有失败，返回错误
+		if result.FailedRecords > 0 || result.FailedZones > 0 {
+			fmt.Println("\n部分操作失败，请检查上述错误信息")
+			os.Exit(1)
+		}
+
+		fmt.Printf("\n✓ 所有记录%s成功\n", label)
+		return nil
 	}
+}
 
-	fmt.Println("\n✓ 所有记录创建成功")
-	return nil
+// printDNSBatchPreview 显示批量操作预览
+func printDNSBatchPreview(op cloudflare.DNSBatchOperation, config *cloudflare.DNSBatchConfig) {
+	fmt.Println("=== 预览模式 ===")
+	fmt.Printf("%s: 总共 %d 个 zone，%d 条记录\n", op.Label(), len(config.Zones), countTotalRecords(config))
+	if op == cloudflare.DNSBatchCreate {
+		policy, _ := cloudflare.ParseConflictPolicy(string(config.OnConflict))
+		fmt.Printf("记录已存在时: %s\n", policy)
+	}
+	if config.OwnerTag != "" {
+		fmt.Printf("所有权标签: %s\n", config.OwnerTag)
+	}
+	fmt.Println()
+
+	for i, zone := range config.Zones {
+		fmt.Printf("Zone %d: %s (%d 条记录)\n", i+1, zone.Zone, len(zone.Records))
+		for j, record := range zone.Records {
+			fmt.Printf("  %d. %s %s", j+1, record.Type, record.Name)
+			if op == cloudflare.DNSBatchDelete {
+				// 删除时 content 也用于匹配
+				matchContent := record.MatchContent
+				if matchContent == "" {
+					matchContent = record.Content
+				}
+				if matchContent != "" {
+					fmt.Printf(" [匹配: %s]", matchContent)
+				}
+			} else {
+				if record.MatchContent != "" {
+					fmt.Printf(" [匹配: %s]", record.MatchContent)
+				}
+				fmt.Printf(" -> %s", record.Content)
+				if record.TTL > 0 && record.TTL != 1 {
+					fmt.Printf(" (TTL: %.0f)", record.TTL)
+				}
+				if record.Proxied != nil {
+					if *record.Proxied {
+						fmt.Print(" [Proxied]")
+					} else if op == cloudflare.DNSBatchUpdate {
+						fmt.Print(" [关闭代理]")
+					}
+				}
+				if len(record.Tags) > 0 {
+					fmt.Printf(" [标签: %s]", strings.Join(record.Tags, ","))
+				}
+				if record.Comment != "" {
+					fmt.Printf(" (备注: %s)", record.Comment)
+				}
+			}
+			fmt.Println()
+		}
+		fmt.Println()
+	}
 }
 
 // countTotalRecords 计算总记录数
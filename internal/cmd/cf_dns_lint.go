@@ -0,0 +1,265 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ado1t/cloudctl/internal/aws"
+	"github.com/ado1t/cloudctl/internal/cloudflare"
+	"github.com/ado1t/cloudctl/internal/logger"
+)
+
+func init() {
+	cfDnsCmd.AddCommand(cfDnsLintCmd)
+
+	// dns lint 命令参数
+	cfDnsLintCmd.Flags().StringP("file", "f", "", "检查批量操作配置文件 (YAML) 中的记录")
+	cfDnsLintCmd.Flags().Bool("cloudfront", false, "检查指向已删除 CloudFront 分发的 CNAME（需要 AWS 凭证）")
+	cfDnsLintCmd.Flags().String("aws-profile", "", "获取 CloudFront 分发使用的 AWS profile")
+}
+
+// cfDnsLintCmd 检查 DNS 记录
+var cfDnsLintCmd = &cobra.Command{
+	Use:   "lint [domain...]",
+	Short: "检查 DNS 记录的常见问题",
+	Long: `检查域名的现有 DNS 记录或批量操作配置文件中的记录，发现常见的配置问题。
+
+检查规则:
+  error:
+    - cname-conflict: CNAME 与同名的其他记录共存
+    - proxied-unsupported: 不支持代理的记录类型启用了代理
+    - invalid-ip: A/AAAA 记录的内容不是有效的 IPv4/IPv6 地址
+    - dangling-cloudfront: CNAME 指向已删除的 CloudFront 分发（需要 --cloudfront）
+  warning:
+    - private-ip: A/AAAA 记录指向内网地址
+    - ttl-proxied: 启用代理时设置了 TTL（代理记录的 TTL 固定为自动）
+    - apex-cname: 根域名使用 CNAME（会被 Cloudflare 展平）
+
+发现 error 级别的问题时命令返回非零退出码。
+cf dns create、update、batch-create 和 batch-update 在执行前也会进行检查，使用 --skip-lint 跳过。
+
+使用示例:
+  # 检查域名的现有记录
+  cloudctl cf dns lint example.com test.com
+
+  # 检查配置文件中的记录（不需要 Cloudflare 凭证）
+  cloudctl cf dns lint -f dns-records.yaml
+
+  # 同时检查指向已删除 CloudFront 分发的 CNAME
+  cloudctl cf dns lint example.com --cloudfront --aws-profile prod`,
+	RunE: runDNSLint,
+}
+
+// runDNSLint 执行 dns lint 命令
+func runDNSLint(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// 获取参数
+	profile, _ := cmd.Flags().GetString("profile")
+	file, _ := cmd.Flags().GetString("file")
+	checkCloudFront, _ := cmd.Flags().GetBool("cloudfront")
+	awsProfile, _ := cmd.Flags().GetString("aws-profile")
+
+	if (file == "") == (len(args) == 0) {
+		return fmt.Errorf("请指定域名或使用 -f 指定配置文件（二选一）")
+	}
+
+	var opts cloudflare.LintOptions
+	if checkCloudFront {
+		domains, err := cloudFrontDomains(ctx, awsProfile)
+		if err != nil {
+			return err
+		}
+		opts.CloudFrontDomains = domains
+	}
+
+	var issues []cloudflare.LintIssue
+	if file != "" {
+		config, err := cloudflare.LoadDNSBatchConfigFor(file, cloudflare.DNSBatchCreate)
+		if err != nil {
+			logger.Error("加载配置文件失败", "error", err)
+			fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+			os.Exit(cloudflare.GetExitCode(err))
+		}
+		issues = lintDNSBatchConfig(config, opts)
+	} else {
+		// 创建 Cloudflare 客户端
+		logger.Debug("创建 Cloudflare 客户端", "profile", profile)
+		client, err := cloudflare.NewClient(profile, logger.Logger)
+		if err != nil {
+			logger.Error("创建客户端失败", "error", err)
+			fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+			os.Exit(cloudflare.GetExitCode(err))
+		}
+		defer client.Close()
+
+		for _, domain := range args {
+			logger.Info("正在检查 DNS 记录...", "domain", domain)
+			zone, err := client.GetZoneByName(ctx, domain)
+			if err != nil {
+				logger.Error("查找域名失败", "error", err)
+				fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+				os.Exit(cloudflare.GetExitCode(err))
+			}
+
+			records, err := client.ListDNSRecords(ctx, zone.ID, "")
+			if err != nil {
+				logger.Error("获取 DNS 记录失败", "error", err)
+				fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+				os.Exit(cloudflare.GetExitCode(err))
+			}
+
+			issues = append(issues, cloudflare.LintRecords(zone.Name, records, opts)...)
+		}
+	}
+
+	if len(issues) == 0 {
+		fmt.Println("✓ 没有发现问题")
+		return nil
+	}
+
+	if err := GetFormatter().Format(lintIssuesToRows(issues)); err != nil {
+		logger.Error("格式化输出失败", "error", err)
+		return fmt.Errorf("格式化输出失败: %w", err)
+	}
+
+	if cloudflare.HasLintErrors(issues) {
+		return fmt.Errorf("发现 %d 个问题，其中包含 error 级别的问题", len(issues))
+	}
+	return nil
+}
+
+// lintDNSBatchConfig 检查批量操作配置中每个 zone 的记录
+func lintDNSBatchConfig(config *cloudflare.DNSBatchConfig, opts cloudflare.LintOptions) []cloudflare.LintIssue {
+	var issues []cloudflare.LintIssue
+	for _, zone := range config.Zones {
+		records := cloudflare.RecordsFromConfig(zone.Zone, zone.Records)
+		issues = append(issues, cloudflare.LintRecords(zone.Zone, records, opts)...)
+	}
+	return issues
+}
+
+// lintDNSBatchConfigWithExisting 结合每个 zone 的现有记录检查批量创建或更新配置中的记录
+// 与新记录同名同类型的现有记录会被更新或由冲突处理策略处理，不参与冲突检查；
+// 批量更新时未配置的 TTL 和 proxied 取匹配的现有记录的值。
+// 无法获取现有记录的 zone 只检查配置中的记录，并在 w 中说明原因
+func lintDNSBatchConfigWithExisting(ctx context.Context, w io.Writer, client *cloudflare.Client, config *cloudflare.DNSBatchConfig, op cloudflare.DNSBatchOperation, opts cloudflare.LintOptions) []cloudflare.LintIssue {
+	var issues []cloudflare.LintIssue
+	for _, zoneConfig := range config.Zones {
+		zone, existing, err := zoneDNSRecords(ctx, client, zoneConfig.Zone)
+		if err != nil {
+			logger.Warn("获取现有 DNS 记录失败，只检查配置中的记录", "zone", zoneConfig.Zone, "error", err)
+			fmt.Fprintf(w, "⚠ 无法获取 %s 的现有记录，只检查配置中的记录: %v\n", zoneConfig.Zone, err)
+			records := cloudflare.RecordsFromConfig(zoneConfig.Zone, zoneConfig.Records)
+			issues = append(issues, cloudflare.LintRecords(zoneConfig.Zone, records, opts)...)
+			continue
+		}
+
+		newRecords := cloudflare.RecordsFromConfig(zone.Name, zoneConfig.Records)
+		if op == cloudflare.DNSBatchUpdate {
+			for i, recordConfig := range zoneConfig.Records {
+				idx := slices.IndexFunc(existing, func(record cloudflare.DNSRecordInfo) bool {
+					return sameTypeAndName(record, newRecords[i]) &&
+						(recordConfig.MatchContent == "" || strings.EqualFold(record.Content, recordConfig.MatchContent))
+				})
+				if idx < 0 {
+					continue
+				}
+				if recordConfig.TTL == 0 {
+					newRecords[i].TTL = existing[idx].TTL
+				}
+				if recordConfig.Proxied == nil {
+					newRecords[i].Proxied = existing[idx].Proxied
+				}
+			}
+		}
+
+		existing = slices.DeleteFunc(existing, func(record cloudflare.DNSRecordInfo) bool {
+			return slices.ContainsFunc(newRecords, func(newRecord cloudflare.DNSRecordInfo) bool {
+				return sameTypeAndName(record, newRecord)
+			})
+		})
+		issues = append(issues, cloudflare.LintNewRecords(zone.Name, existing, newRecords, opts)...)
+	}
+	return issues
+}
+
+// zoneDNSRecords 获取域名及其所有现有记录
+func zoneDNSRecords(ctx context.Context, client *cloudflare.Client, domain string) (*cloudflare.ZoneInfo, []cloudflare.DNSRecordInfo, error) {
+	zone, err := client.GetZoneByName(ctx, domain)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	records, err := client.ListDNSRecords(ctx, zone.ID, "")
+	if err != nil {
+		return nil, nil, err
+	}
+	return zone, records, nil
+}
+
+// sameTypeAndName 检查两条记录的类型和名称是否相同
+func sameTypeAndName(a, b cloudflare.DNSRecordInfo) bool {
+	return strings.EqualFold(a.Type, b.Type) &&
+		strings.EqualFold(strings.TrimSuffix(a.Name, "."), strings.TrimSuffix(b.Name, "."))
+}
+
+// cloudFrontDomains 获取所有 CloudFront 分发的默认域名
+func cloudFrontDomains(ctx context.Context, profile string) (map[string]bool, error) {
+	client, err := aws.NewClient(profile)
+	if err != nil {
+		return nil, fmt.Errorf("创建 AWS 客户端失败: %w", err)
+	}
+
+	logger.Info("正在获取 CloudFront 分发列表...")
+	distributions, err := client.ListDistributions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取 CloudFront 分发列表失败: %w", err)
+	}
+
+	domains := make(map[string]bool, len(distributions))
+	for _, dist := range distributions {
+		domains[strings.ToLower(dist.DomainName)] = true
+	}
+	return domains, nil
+}
+
+// lintIssuesToRows 转换检查结果为输出格式
+func lintIssuesToRows(issues []cloudflare.LintIssue) []map[string]interface{} {
+	rows := make([]map[string]interface{}, 0, len(issues))
+	for _, issue := range issues {
+		rows = append(rows, map[string]interface{}{
+			"zone":     issue.Zone,
+			"name":     issue.Record.Name,
+			"type":     issue.Record.Type,
+			"content":  issue.Record.Content,
+			"rule":     issue.Rule,
+			"severity": issue.Severity,
+			"message":  issue.Message,
+		})
+	}
+	return rows
+}
+
+// reportLintIssues 在执行变更前输出检查结果，存在 error 级别的问题时返回错误
+func reportLintIssues(w io.Writer, issues []cloudflare.LintIssue) error {
+	for _, issue := range issues {
+		mark := "⚠"
+		if issue.Severity == cloudflare.LintError {
+			mark = "✗"
+		}
+		fmt.Fprintf(w, "%s [%s] %s %s -> %s: %s\n",
+			mark, issue.Rule, issue.Record.Type, issue.Record.Name, issue.Record.Content, issue.Message)
+	}
+
+	if !cloudflare.HasLintErrors(issues) {
+		return nil
+	}
+	return fmt.Errorf("DNS 记录检查未通过，修正上述错误或使用 --skip-lint 跳过检查")
+}
@@ -0,0 +1,290 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ado1t/cloudctl/internal/cloudflare"
+	"github.com/ado1t/cloudctl/internal/logger"
+)
+
+func init() {
+	cfDnsCmd.AddCommand(cfDnsSnapshotCmd)
+	cfDnsCmd.AddCommand(cfDnsRestoreCmd)
+
+	// dns snapshot 命令参数
+	cfDnsSnapshotCmd.Flags().StringP("file", "f", "", "快照文件路径 (JSON)")
+	cfDnsSnapshotCmd.MarkFlagRequired("file")
+
+	// dns restore 命令参数
+	cfDnsRestoreCmd.Flags().StringSlice("zones", nil, "只恢复指定的域名，多个域名用逗号分隔")
+	cfDnsRestoreCmd.Flags().Bool("dry-run", false, "预览模式，只显示变更")
+	cfDnsRestoreCmd.Flags().BoolP("yes", "y", false, "跳过确认提示")
+	cfDnsRestoreCmd.Flags().Bool("force", false, "跳过不支持恢复的变更，执行其他变更")
+}
+
+// cfDnsSnapshotCmd 保存 DNS 记录快照
+var cfDnsSnapshotCmd = &cobra.Command{
+	Use:   "snapshot <domain>...",
+	Short: "保存 DNS 记录快照",
+	Long: `将一个或多个域名的所有 DNS 记录（包括记录 ID、备注和标签）保存为 JSON 快照，
+用于在高风险变更前备份，之后可通过 restore 恢复。
+
+使用示例:
+  cloudctl cf dns snapshot example.com -f example.com.json
+  cloudctl cf dns snapshot example.com test.com -f snap-$(date +%Y%m%d).json`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runDNSSnapshot,
+}
+
+// runDNSSnapshot 执行 dns snapshot 命令
+func runDNSSnapshot(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// 获取参数
+	profile, _ := cmd.Flags().GetString("profile")
+	file, _ := cmd.Flags().GetString("file")
+
+	// 创建 Cloudflare 客户端
+	logger.Debug("创建 Cloudflare 客户端", "profile", profile)
+	client, err := cloudflare.NewClient(profile, logger.Logger)
+	if err != nil {
+		logger.Error("创建客户端失败", "error", err)
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
+	}
+	defer client.Close()
+
+	logger.Info("正在获取 DNS 记录快照...", "zones", len(args))
+	snapshot, err := client.SnapshotDNS(ctx, args)
+	if err != nil {
+		logger.Error("获取快照失败", "error", err)
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
+	}
+
+	if err := cloudflare.SaveDNSSnapshot(file, snapshot); err != nil {
+		logger.Error("保存快照失败", "error", err)
+		return err
+	}
+
+	data := make([]map[string]interface{}, 0, len(snapshot.Zones))
+	for _, zone := range snapshot.Zones {
+		data = append(data, map[string]interface{}{
+			"name":    zone.Zone,
+			"zone_id": zone.ZoneID,
+			"records": len(zone.Records),
+		})
+	}
+
+	if err := GetFormatter().Format(data); err != nil {
+		logger.Error("格式化输出失败", "error", err)
+		return fmt.Errorf("格式化输出失败: %w", err)
+	}
+
+	logger.Info("快照已保存", "file", file)
+	return nil
+}
+
+// cfDnsRestoreCmd 从快照恢复 DNS 记录
+var cfDnsRestoreCmd = &cobra.Command{
+	Use:   "restore <snapshot.json>",
+	Short: "从快照恢复 DNS 记录",
+	Long: `比较快照与域名当前的 DNS 记录，通过创建、更新和删除记录恢复到快照时的状态:
+  - 快照中有、当前没有的记录会被创建
+  - 内容、TTL、代理、备注或标签不同的记录会被更新
+  - 当前有、快照中没有的记录会被删除
+
+记录先按 ID 匹配，记录被删除后重建（ID 变化）时按类型、名称和内容匹配。
+只支持创建和更新 A、AAAA 和 CNAME 记录，其他类型的变更会在 note 列中标记，
+对应的当前记录不会被删除。存在这类变更时拒绝执行，使用 --force 跳过这些变更并执行其他变更。
+执行前会显示变更并要求确认，使用 --dry-run 只查看变更。
+
+使用示例:
+  # 预览恢复变更
+  cloudctl cf dns restore snap.json --dry-run
+
+  # 只恢复快照中的一个域名
+  cloudctl cf dns restore snap.json --zones example.com -y`,
+	Args: cobra.ExactArgs(1),
+	RunE: runDNSRestore,
+}
+
+// runDNSRestore 执行 dns restore 命令
+func runDNSRestore(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// 获取参数
+	profile, _ := cmd.Flags().GetString("profile")
+	zoneFilter, _ := cmd.Flags().GetStringSlice("zones")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	yes, _ := cmd.Flags().GetBool("yes")
+	force, _ := cmd.Flags().GetBool("force")
+
+	snapshot, err := cloudflare.LoadDNSSnapshot(args[0])
+	if err != nil {
+		logger.Error("加载快照失败", "error", err)
+		return err
+	}
+
+	zones, err := filterSnapshotZones(snapshot, zoneFilter)
+	if err != nil {
+		return err
+	}
+
+	// 创建 Cloudflare 客户端
+	logger.Debug("创建 Cloudflare 客户端", "profile", profile)
+	client, err := cloudflare.NewClient(profile, logger.Logger)
+	if err != nil {
+		logger.Error("创建客户端失败", "error", err)
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
+	}
+	defer client.Close()
+
+	var changes []cloudflare.DNSRestoreChange
+	for _, zone := range zones {
+		logger.Info("正在比较快照与当前记录...", "zone", zone.Zone)
+		zoneChanges, err := client.PlanDNSRestore(ctx, zone)
+		if err != nil {
+			logger.Error("计算恢复变更失败", "zone", zone.Zone, "error", err)
+			fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+			os.Exit(cloudflare.GetExitCode(err))
+		}
+		changes = append(changes, zoneChanges...)
+	}
+
+	if len(changes) == 0 {
+		fmt.Printf("当前记录与快照 (%s) 一致，无需恢复\n", snapshot.CreatedAt.Format("2006-01-02 15:04:05"))
+		return nil
+	}
+
+	if err := GetFormatter().Format(restoreChangesToRows(changes)); err != nil {
+		logger.Error("格式化输出失败", "error", err)
+		return fmt.Errorf("格式化输出失败: %w", err)
+	}
+
+	supported, unsupported := cloudflare.SplitUnsupportedChanges(changes)
+
+	if dryRun {
+		fmt.Printf("\n预览模式: 共 %d 项变更，未执行\n", len(changes))
+		if len(unsupported) > 0 {
+			fmt.Printf("其中 %d 项变更不支持恢复，执行时需要 --force 跳过\n", len(unsupported))
+		}
+		return nil
+	}
+
+	if len(unsupported) > 0 {
+		if !force {
+			return fmt.Errorf("有 %d 项变更不支持恢复，使用 --force 跳过这些变更并执行其他变更", len(unsupported))
+		}
+		fmt.Fprintf(os.Stderr, "⚠ 跳过 %d 项不支持恢复的变更\n", len(unsupported))
+		changes = supported
+		if len(changes) == 0 {
+			fmt.Println("没有可以执行的变更")
+			return nil
+		}
+	}
+
+	if !yes {
+		fmt.Printf("\n将执行以上 %d 项变更，恢复到快照时间 %s\n", len(changes), snapshot.CreatedAt.Format("2006-01-02 15:04:05"))
+		fmt.Print("输入 'yes' 确认: ")
+
+		var confirm string
+		fmt.Scanln(&confirm)
+
+		if confirm != "yes" {
+			fmt.Println("已取消恢复操作")
+			return nil
+		}
+	}
+
+	// 进度回调
+	progressCallback := func(msg string) {
+		logger.Info(msg)
+	}
+
+	results := client.ApplyDNSRestore(ctx, changes, progressCallback)
+
+	failed := 0
+	for _, result := range results {
+		if result.Success {
+			continue
+		}
+		failed++
+		record := result.Change.Record()
+		fmt.Fprintf(os.Stderr, "✗ %s %s %s %s: %s\n",
+			result.Change.Zone, result.Change.Action, record.Type, record.Name, cloudflare.FormatError(result.Error))
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("有 %d 项变更执行失败", failed)
+	}
+
+	fmt.Printf("\n✓ 已恢复 %d 项变更\n", len(results))
+	return nil
+}
+
+// filterSnapshotZones 选择要恢复的域名，filter 为空时返回快照中的所有域名
+func filterSnapshotZones(snapshot *cloudflare.DNSSnapshot, filter []string) ([]cloudflare.DNSZoneSnapshot, error) {
+	if len(filter) == 0 {
+		return snapshot.Zones, nil
+	}
+
+	var zones []cloudflare.DNSZoneSnapshot
+	for _, name := range filter {
+		idx := slices.IndexFunc(snapshot.Zones, func(zone cloudflare.DNSZoneSnapshot) bool {
+			return strings.EqualFold(zone.Zone, strings.TrimSpace(name))
+		})
+		if idx < 0 {
+			return nil, fmt.Errorf("快照中没有域名: %s", name)
+		}
+		zones = append(zones, snapshot.Zones[idx])
+	}
+	return zones, nil
+}
+
+// restoreChangesToRows 转换恢复变更为输出格式
+func restoreChangesToRows(changes []cloudflare.DNSRestoreChange) []map[string]interface{} {
+	rows := make([]map[string]interface{}, 0, len(changes))
+	for _, change := range changes {
+		record := change.Record()
+		row := map[string]interface{}{
+			"zone":    change.Zone,
+			"action":  change.Action,
+			"type":    record.Type,
+			"name":    record.Name,
+			"current": "",
+			"desired": "",
+			"note":    change.Unsupported,
+		}
+		if change.Current != nil {
+			row["current"] = describeRestoreRecord(*change.Current)
+		}
+		if change.Desired != nil {
+			row["desired"] = describeRestoreRecord(*change.Desired)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// describeRestoreRecord 描述记录的内容和属性
+func describeRestoreRecord(record cloudflare.DNSRecordInfo) string {
+	parts := []string{record.Content, "ttl=" + formatTTL(record.TTL)}
+	if record.Proxied {
+		parts = append(parts, "proxied")
+	}
+	if record.Comment != "" {
+		parts = append(parts, fmt.Sprintf("comment=%q", record.Comment))
+	}
+	if len(record.Tags) > 0 {
+		parts = append(parts, "tags="+strings.Join(record.Tags, ","))
+	}
+	return strings.Join(parts, " ")
+}
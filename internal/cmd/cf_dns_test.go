@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/ado1t/cloudctl/internal/cloudflare"
+)
+
+func TestUpdatedDNSRecords(t *testing.T) {
+	www := cloudflare.DNSRecordInfo{ID: "1", Type: "A", Name: "www.example.com", Content: "192.0.2.1", TTL: 300}
+	api := cloudflare.DNSRecordInfo{ID: "2", Type: "CNAME", Name: "api.example.com", Content: "lb.example.net", TTL: 1, Proxied: true}
+	zoneRecords := []cloudflare.DNSRecordInfo{www, api}
+
+	content := "10.0.0.1"
+	others, updated := updatedDNSRecords(zoneRecords, []cloudflare.DNSRecordInfo{www}, cloudflare.DNSRecordUpdateParams{Content: &content})
+
+	if len(others) != 1 || others[0].ID != "2" {
+		t.Errorf("others = %+v, want 只包含记录 2", others)
+	}
+	if len(updated) != 1 || updated[0].Content != content || updated[0].TTL != 300 {
+		t.Errorf("updated = %+v, want 内容为 %s 且保留 TTL", updated, content)
+	}
+	if zoneRecords[0].Content != "192.0.2.1" {
+		t.Error("不应修改传入的记录")
+	}
+
+	// 更新后的记录指向内网地址，检查应报告 warning
+	issues := cloudflare.LintNewRecords("example.com", others, updated, cloudflare.LintOptions{})
+	if len(issues) != 1 || issues[0].Rule != cloudflare.RulePrivateIP {
+		t.Errorf("issues = %+v, want 一个 %s", issues, cloudflare.RulePrivateIP)
+	}
+}
@@ -3,20 +3,42 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"net"
 	"os"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/ado1t/cloudctl/internal/cloudflare"
 	"github.com/ado1t/cloudctl/internal/logger"
 	"github.com/ado1t/cloudctl/internal/output"
+	"github.com/ado1t/cloudctl/internal/probe"
 )
 
 func init() {
 	// 添加 zone 子命令
 	cfZoneCmd.AddCommand(cfZoneListCmd)
 	cfZoneCmd.AddCommand(cfZoneCreateCmd)
+	cfZoneCmd.AddCommand(cfZoneGetCmd)
+	cfZoneCmd.AddCommand(cfZoneDeleteCmd)
+	cfZoneCmd.AddCommand(cfZonePauseCmd)
+	cfZoneCmd.AddCommand(cfZoneUnpauseCmd)
+	cfZoneCmd.AddCommand(cfZoneActivationCheckCmd)
+	cfZoneCmd.AddCommand(cfZoneNSCheckCmd)
+
+	// zone list 命令参数
+	cfZoneListCmd.Flags().StringSlice("status", nil, "按状态过滤，多个状态用逗号分隔 (initializing|pending|active|moved|deleted|deactivated)")
+
+	// zone delete 命令参数
+	cfZoneDeleteCmd.Flags().Bool("force", false, "存在启用代理的 DNS 记录时仍然删除")
+	cfZoneDeleteCmd.Flags().BoolP("yes", "y", false, "跳过确认提示")
+
+	// zone ns-check 命令参数
+	cfZoneNSCheckCmd.Flags().String("resolver", "", "查找上级域名权威服务器使用的 DNS 服务器，格式: ip 或 ip:port（默认使用系统解析器）")
+	cfZoneNSCheckCmd.Flags().String("ns-port", probe.DefaultDNSPort, "向上级域名权威服务器查询委派使用的端口")
+	cfZoneNSCheckCmd.Flags().Duration("timeout", probe.DefaultTimeout, "DNS 查询超时时间")
 }
 
 // cfZoneListCmd 列出所有 Zone
@@ -27,13 +49,16 @@ var cfZoneListCmd = &cobra.Command{
 
 显示信息包括:
   - 域名名称
-  - 状态 (active/pending)
+  - 状态 (initializing/pending/active/moved/deleted/deactivated)
+  - 是否暂停代理
+  - 套餐
   - Zone ID
   - 创建时间
 
 使用示例:
   cloudctl cf zone list                    # 使用默认 profile
   cloudctl cf zone list --profile cf-prod  # 使用指定 profile
+  cloudctl cf zone list --status pending   # 只显示待激活的域名
   cloudctl cf zone list -o json            # JSON 格式输出`,
 	RunE: runZoneList,
 }
@@ -42,8 +67,15 @@ var cfZoneListCmd = &cobra.Command{
 func runZoneList(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 
-	// 获取 profile 参数
+	// 获取参数
 	profile, _ := cmd.Flags().GetString("profile")
+	statuses, _ := cmd.Flags().GetStringSlice("status")
+
+	for _, status := range statuses {
+		if !slices.Contains(cloudflare.ZoneStatuses, strings.ToLower(status)) {
+			return fmt.Errorf("无效的状态: %s (可选值: %s)", status, strings.Join(cloudflare.ZoneStatuses, ", "))
+		}
+	}
 
 	// 创建 Cloudflare 客户端
 	logger.Debug("创建 Cloudflare 客户端", "profile", profile)
@@ -64,6 +96,8 @@ func runZoneList(cmd *cobra.Command, args []string) error {
 		os.Exit(cloudflare.GetExitCode(err))
 	}
 
+	zones = cloudflare.FilterZonesByStatus(zones, statuses)
+
 	if len(zones) == 0 {
 		logger.Info("未找到任何 Zone")
 		fmt.Println("未找到任何域名")
@@ -79,6 +113,8 @@ func runZoneList(cmd *cobra.Command, args []string) error {
 		data[i] = map[string]interface{}{
 			"name":       zone.Name,
 			"status":     zone.Status,
+			"paused":     zone.Paused,
+			"plan":       zone.Plan,
 			"id":         zone.ID,
 			"created_on": zone.CreatedOn.Format("2006-01-02 15:04:05"),
 		}
@@ -221,3 +257,433 @@ func runZoneCreate(cmd *cobra.Command, args []string) error {
 	logger.Info("批量创建完成", "成功", len(createdZones), "失败", len(failedDomains))
 	return nil
 }
+
+// cfZoneGetCmd 获取 Zone 详情
+var cfZoneGetCmd = &cobra.Command{
+	Use:   "get <domain>",
+	Short: "获取域名详情",
+	Long: `获取域名 (Zone) 的详细信息，包括状态、暂停状态、类型、套餐和 Name Server。
+
+使用示例:
+  cloudctl cf zone get example.com
+  cloudctl cf zone get example.com -o json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runZoneGet,
+}
+
+// runZoneGet 执行 zone get 命令
+func runZoneGet(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	domain := args[0]
+
+	// 获取 profile 参数
+	profile, _ := cmd.Flags().GetString("profile")
+
+	// 创建 Cloudflare 客户端
+	logger.Debug("创建 Cloudflare 客户端", "profile", profile)
+	client, err := cloudflare.NewClient(profile, logger.Logger)
+	if err != nil {
+		logger.Error("创建客户端失败", "error", err)
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
+	}
+	defer client.Close()
+
+	// 查找 Zone 并获取详情
+	logger.Info("正在查找域名...", "domain", domain)
+	zone, err := client.GetZoneByName(ctx, domain)
+	if err == nil {
+		zone, err = client.GetZone(ctx, zone.ID)
+	}
+	if err != nil {
+		logger.Error("获取域名详情失败", "error", err)
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
+	}
+
+	if err := GetFormatter().Format(zoneDetail(zone)); err != nil {
+		logger.Error("格式化输出失败", "error", err)
+		return fmt.Errorf("格式化输出失败: %w", err)
+	}
+
+	return nil
+}
+
+// cfZoneDeleteCmd 删除 Zone
+var cfZoneDeleteCmd = &cobra.Command{
+	Use:   "delete <domain>",
+	Short: "删除域名",
+	Long: `从 Cloudflare 账户中删除域名 (Zone)，域名下的所有 DNS 记录和配置都会被删除。
+
+域名仍有启用代理的 DNS 记录时会拒绝删除（删除后这些流量将无法访问），使用 --force 强制删除。
+
+注意: 此操作不可逆，请谨慎使用。
+
+使用示例:
+  cloudctl cf zone delete example.com
+  cloudctl cf zone delete example.com --force -y`,
+	Args: cobra.ExactArgs(1),
+	RunE: runZoneDelete,
+}
+
+// runZoneDelete 执行 zone delete 命令
+func runZoneDelete(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	domain := args[0]
+
+	// 获取参数
+	profile, _ := cmd.Flags().GetString("profile")
+	force, _ := cmd.Flags().GetBool("force")
+	yes, _ := cmd.Flags().GetBool("yes")
+
+	// 创建 Cloudflare 客户端
+	logger.Debug("创建 Cloudflare 客户端", "profile", profile)
+	client, err := cloudflare.NewClient(profile, logger.Logger)
+	if err != nil {
+		logger.Error("创建客户端失败", "error", err)
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
+	}
+	defer client.Close()
+
+	// 获取 Zone ID
+	logger.Info("正在查找域名...", "domain", domain)
+	zone, err := client.GetZoneByName(ctx, domain)
+	if err != nil {
+		logger.Error("查找域名失败", "error", err)
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
+	}
+
+	// 检查是否仍有启用代理的记录
+	records, err := client.ListDNSRecords(ctx, zone.ID, "")
+	if err != nil {
+		logger.Error("获取 DNS 记录失败", "error", err)
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
+	}
+
+	proxied := cloudflare.ProxiedRecords(records)
+	if len(proxied) > 0 {
+		fmt.Printf("域名 %s 仍有 %d 条启用代理的 DNS 记录:\n", zone.Name, len(proxied))
+		for _, record := range proxied {
+			fmt.Printf("  - %s %s -> %s\n", record.Type, record.Name, record.Content)
+		}
+		if !force {
+			return fmt.Errorf("域名仍在代理流量，请先处理以上记录或使用 --force 强制删除")
+		}
+		fmt.Println()
+	}
+
+	// 显示确认信息
+	if !yes {
+		fmt.Printf("确认删除以下域名?\n")
+		fmt.Printf("  域名: %s\n", zone.Name)
+		fmt.Printf("  ID: %s\n", zone.ID)
+		fmt.Printf("  状态: %s\n", zone.Status)
+		fmt.Printf("  DNS 记录: %d 条\n", len(records))
+		fmt.Print("\n输入 'yes' 确认删除: ")
+
+		var confirm string
+		fmt.Scanln(&confirm)
+
+		if confirm != "yes" {
+			fmt.Println("已取消删除操作")
+			return nil
+		}
+	}
+
+	// 删除 Zone
+	logger.Info("正在删除域名...", "zone_id", zone.ID)
+	if err := client.DeleteZone(ctx, zone.ID); err != nil {
+		logger.Error("删除域名失败", "error", err)
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
+	}
+
+	data := map[string]interface{}{
+		"status":  "deleted",
+		"zone_id": zone.ID,
+		"name":    zone.Name,
+	}
+
+	if err := GetFormatter().Format(data); err != nil {
+		logger.Error("格式化输出失败", "error", err)
+		return fmt.Errorf("格式化输出失败: %w", err)
+	}
+
+	logger.Info("成功删除域名", "zone_id", zone.ID)
+	return nil
+}
+
+// cfZonePauseCmd 暂停 Zone
+var cfZonePauseCmd = &cobra.Command{
+	Use:   "pause <domain>",
+	Short: "暂停域名的 Cloudflare 代理",
+	Long: `暂停域名 (Zone) 的 Cloudflare 代理，暂停后流量直接回源，DNS 仍由 Cloudflare 解析。
+
+使用示例:
+  cloudctl cf zone pause example.com`,
+	Args: cobra.ExactArgs(1),
+	RunE: runZoneSetPaused(true),
+}
+
+// cfZoneUnpauseCmd 恢复 Zone
+var cfZoneUnpauseCmd = &cobra.Command{
+	Use:   "unpause <domain>",
+	Short: "恢复域名的 Cloudflare 代理",
+	Long: `恢复已暂停域名 (Zone) 的 Cloudflare 代理。
+
+使用示例:
+  cloudctl cf zone unpause example.com`,
+	Args: cobra.ExactArgs(1),
+	RunE: runZoneSetPaused(false),
+}
+
+// runZoneSetPaused 返回执行 zone pause/unpause 命令的函数
+func runZoneSetPaused(paused bool) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		domain := args[0]
+
+		// 获取 profile 参数
+		profile, _ := cmd.Flags().GetString("profile")
+
+		// 创建 Cloudflare 客户端
+		logger.Debug("创建 Cloudflare 客户端", "profile", profile)
+		client, err := cloudflare.NewClient(profile, logger.Logger)
+		if err != nil {
+			logger.Error("创建客户端失败", "error", err)
+			fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+			os.Exit(cloudflare.GetExitCode(err))
+		}
+		defer client.Close()
+
+		// 获取 Zone ID
+		logger.Info("正在查找域名...", "domain", domain)
+		zone, err := client.GetZoneByName(ctx, domain)
+		if err != nil {
+			logger.Error("查找域名失败", "error", err)
+			fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+			os.Exit(cloudflare.GetExitCode(err))
+		}
+
+		zone, err = client.SetZonePaused(ctx, zone.ID, paused)
+		if err != nil {
+			logger.Error("设置域名暂停状态失败", "error", err)
+			fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+			os.Exit(cloudflare.GetExitCode(err))
+		}
+
+		if err := GetFormatter().Format(zoneDetail(zone)); err != nil {
+			logger.Error("格式化输出失败", "error", err)
+			return fmt.Errorf("格式化输出失败: %w", err)
+		}
+
+		return nil
+	}
+}
+
+// cfZoneActivationCheckCmd 触发激活检查
+var cfZoneActivationCheckCmd = &cobra.Command{
+	Use:   "activation-check <domain>",
+	Short: "重新检查域名的 Name Server 配置",
+	Long: `触发 Cloudflare 重新检查待激活域名 (Zone) 的 Name Server 配置。
+
+在域名注册商处修改 Name Server 后，可以使用该命令加快激活。
+Cloudflare 对该操作有频率限制（免费套餐每小时一次左右）。
+
+使用示例:
+  cloudctl cf zone activation-check example.com`,
+	Args: cobra.ExactArgs(1),
+	RunE: runZoneActivationCheck,
+}
+
+// runZoneActivationCheck 执行 zone activation-check 命令
+func runZoneActivationCheck(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	domain := args[0]
+
+	// 获取 profile 参数
+	profile, _ := cmd.Flags().GetString("profile")
+
+	// 创建 Cloudflare 客户端
+	logger.Debug("创建 Cloudflare 客户端", "profile", profile)
+	client, err := cloudflare.NewClient(profile, logger.Logger)
+	if err != nil {
+		logger.Error("创建客户端失败", "error", err)
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
+	}
+	defer client.Close()
+
+	// 获取 Zone ID
+	logger.Info("正在查找域名...", "domain", domain)
+	zone, err := client.GetZoneByName(ctx, domain)
+	if err != nil {
+		logger.Error("查找域名失败", "error", err)
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
+	}
+
+	if zone.Status == "active" {
+		fmt.Printf("域名 %s 已激活，无需检查\n", zone.Name)
+		return nil
+	}
+
+	if err := client.TriggerActivationCheck(ctx, zone.ID); err != nil {
+		logger.Error("触发激活检查失败", "error", err)
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
+	}
+
+	fmt.Printf("✓ 已触发域名 %s 的激活检查，请稍后使用 cloudctl cf zone get %s 查看状态\n", zone.Name, zone.Name)
+	fmt.Println("\n请确认域名注册商处已配置以下 Name Server:")
+	for _, ns := range zone.NameServers {
+		fmt.Printf("  - %s\n", ns)
+	}
+
+	return nil
+}
+
+// cfZoneNSCheckCmd 检查 Name Server 委派
+var cfZoneNSCheckCmd = &cobra.Command{
+	Use:   "ns-check <domain>...",
+	Short: "检查域名的 Name Server 委派",
+	Long: `向上级域名（如 com）的权威服务器查询域名的 NS 委派，与 Cloudflare 分配的 Name Server 比较，报告委派状态:
+  - correct    已完全委派到 Cloudflare 分配的 Name Server
+  - partial    只委派了部分 Cloudflare Name Server，或混有其他 Name Server
+  - incorrect  没有委派到 Cloudflare 分配的 Name Server
+
+配置了自定义 Name Server 的域名与自定义 Name Server 比较。
+--resolver 只用于查找上级域名的权威服务器，委派结果直接来自权威服务器，不受递归解析器缓存影响。
+--ns-port 修改查询权威服务器的端口，结合 --resolver 可以使用本地 DNS 服务器测试。
+任一域名委派不正确时命令返回非零退出码。
+
+使用示例:
+  cloudctl cf zone ns-check example.com test.com
+  cloudctl cf zone ns-check example.com --resolver 1.1.1.1
+  cloudctl cf zone ns-check example.com --resolver 127.0.0.1:5353 --ns-port 5353`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runZoneNSCheck,
+}
+
+// runZoneNSCheck 执行 zone ns-check 命令
+func runZoneNSCheck(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// 获取参数
+	profile, _ := cmd.Flags().GetString("profile")
+	resolverAddr, _ := cmd.Flags().GetString("resolver")
+	nsPort, _ := cmd.Flags().GetString("ns-port")
+	timeout, _ := cmd.Flags().GetDuration("timeout")
+
+	// 创建 Cloudflare 客户端
+	logger.Debug("创建 Cloudflare 客户端", "profile", profile)
+	client, err := cloudflare.NewClient(profile, logger.Logger)
+	if err != nil {
+		logger.Error("创建客户端失败", "error", err)
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
+	}
+	defer client.Close()
+
+	resolver := probe.NewResolver(resolverAddr, timeout)
+
+	failed := 0
+	data := make([]map[string]interface{}, 0, len(args))
+	for _, domain := range args {
+		logger.Info("正在检查 Name Server 委派...", "domain", domain)
+
+		zone, err := client.GetZoneByName(ctx, domain)
+		if err != nil {
+			logger.Error("查找域名失败", "domain", domain, "error", err)
+			data = append(data, map[string]interface{}{
+				"name":       domain,
+				"status":     "",
+				"delegation": "error",
+				"expected":   "",
+				"actual":     "",
+				"missing":    "",
+				"extra":      "",
+				"error":      cloudflare.FormatError(err),
+			})
+			failed++
+			continue
+		}
+
+		row, ok := checkZoneDelegation(ctx, resolver, nsPort, timeout, zone)
+		if !ok {
+			failed++
+		}
+		data = append(data, row)
+	}
+
+	if err := GetFormatter().Format(data); err != nil {
+		logger.Error("格式化输出失败", "error", err)
+		return fmt.Errorf("格式化输出失败: %w", err)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("有 %d 个域名的 Name Server 委派不正确", failed)
+	}
+
+	return nil
+}
+
+// checkZoneDelegation 查询 Zone 的 NS 委派并与 Cloudflare 分配的 Name Server 比较
+// port 为向上级域名权威服务器查询使用的端口，返回输出行以及委派是否正确
+func checkZoneDelegation(ctx context.Context, resolver *net.Resolver, port string, timeout time.Duration, zone *cloudflare.ZoneInfo) (map[string]interface{}, bool) {
+	expected := zone.NameServers
+	if len(zone.VanityNameServers) > 0 {
+		expected = zone.VanityNameServers
+	}
+
+	lookupCtx, cancel := context.WithTimeout(ctx, timeout)
+	actual, lookupErr := probe.LookupDelegation(lookupCtx, resolver, zone.Name, port)
+	cancel()
+
+	check := cloudflare.CompareNameServers(expected, actual)
+	row := map[string]interface{}{
+		"name":       zone.Name,
+		"status":     zone.Status,
+		"delegation": check.Status,
+		"expected":   check.Expected,
+		"actual":     check.Actual,
+		"missing":    check.Missing,
+		"extra":      check.Extra,
+		"error":      "",
+	}
+	if lookupErr != nil {
+		logger.Warn("查询 NS 委派失败", "domain", zone.Name, "error", lookupErr)
+		row["error"] = lookupErr.Error()
+	}
+	return row, check.Status == cloudflare.DelegationCorrect
+}
+
+// zoneDetail 转换 Zone 详情为输出格式
+func zoneDetail(zone *cloudflare.ZoneInfo) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":         zone.Name,
+		"id":           zone.ID,
+		"status":       zone.Status,
+		"paused":       zone.Paused,
+		"type":         zone.Type,
+		"plan":         zone.Plan,
+		"name_servers": zone.NameServers,
+		"created_on":   zone.CreatedOn.Format("2006-01-02 15:04:05"),
+	}
+	if len(zone.VanityNameServers) > 0 {
+		data["vanity_name_servers"] = zone.VanityNameServers
+	}
+	if len(zone.OriginalNameServers) > 0 {
+		data["original_name_servers"] = zone.OriginalNameServers
+	}
+	if zone.OriginalRegistrar != "" {
+		data["original_registrar"] = zone.OriginalRegistrar
+	}
+	if !zone.ActivatedOn.IsZero() {
+		data["activated_on"] = zone.ActivatedOn.Format("2006-01-02 15:04:05")
+	}
+	return data
+}
@@ -0,0 +1,238 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ado1t/cloudctl/internal/cloudflare"
+	"github.com/ado1t/cloudctl/internal/logger"
+	"github.com/ado1t/cloudctl/internal/output"
+)
+
+func init() {
+	cfZoneCmd.AddCommand(cfZoneOnboardCmd)
+
+	// zone onboard 命令参数
+	cfZoneOnboardCmd.Flags().StringP("file", "f", "", "接入配置文件 (YAML)")
+	cfZoneOnboardCmd.Flags().Int("concurrency", 3, "并发数 (1-10)")
+	cfZoneOnboardCmd.Flags().Bool("dry-run", false, "预览模式，不实际执行")
+	cfZoneOnboardCmd.Flags().String("report", "", "将接入报告写入文件，格式由扩展名决定 (.csv|.json)")
+	cfZoneOnboardCmd.Flags().String("owner-tag", "", "所有权标签，覆盖配置文件中的 owner_tag (例如 "+cloudflare.DefaultOwnerTag+")")
+	cfZoneOnboardCmd.MarkFlagRequired("file")
+}
+
+// cfZoneOnboardCmd 批量接入域名
+var cfZoneOnboardCmd = &cobra.Command{
+	Use:   "onboard",
+	Short: "批量接入域名",
+	Long: `通过 YAML 配置文件批量接入域名:
+  1. 创建 Zone（已存在的域名直接使用，不会重复创建）
+  2. 导入配置中的初始 DNS 记录（已存在的相同记录视为成功；配置了 owner_tag 时为其添加所有权标签，
+     并更新带有该标签的记录）
+  3. 应用设置基线（只修改与基线不一致的设置）
+
+完成后输出每个域名分配到的 Name Server，可通过 --report 导出为 CSV 或 JSON，
+交给注册商修改 Name Server。任一域名接入失败时命令返回非零退出码。
+
+配置文件示例 (zones.yaml):
+  baseline: zone-settings.yaml   # 可选，相对路径基于配置文件所在目录
+  settings:                      # 可选，覆盖基线中的同名设置
+    ssl: strict
+  owner_tag: managed-by:cloudctl # 可选，导入的记录添加所有权标签，之后可用批量操作管理
+  zones:
+    - name: example.com
+      records:
+        - type: A
+          name: www
+          content: 1.2.3.4
+          proxied: true
+    - name: test.com
+
+使用示例:
+  # 预览接入计划
+  cloudctl cf zone onboard -f zones.yaml --dry-run
+
+  # 接入并导出 Name Server 报告
+  cloudctl cf zone onboard -f zones.yaml --report nameservers.csv`,
+	Args: cobra.NoArgs,
+	RunE: runZoneOnboard,
+}
+
+// runZoneOnboard 执行 zone onboard 命令
+func runZoneOnboard(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// 获取参数
+	profile, _ := cmd.Flags().GetString("profile")
+	configFile, _ := cmd.Flags().GetString("file")
+	concurrency, _ := cmd.Flags().GetInt("concurrency")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	reportFile, _ := cmd.Flags().GetString("report")
+
+	// 验证并发数
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	if concurrency > 10 {
+		concurrency = 10
+	}
+
+	if reportFile != "" {
+		if _, err := reportFormatter(reportFile, nil); err != nil {
+			return err
+		}
+	}
+
+	// 加载配置文件
+	logger.Info("加载接入配置文件", "file", configFile)
+	config, err := cloudflare.LoadOnboardConfig(configFile)
+	if err != nil {
+		logger.Error("加载配置文件失败", "error", err)
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
+	}
+
+	// 命令行参数覆盖配置文件中的所有权标签
+	if cmd.Flags().Changed("owner-tag") {
+		ownerTag, _ := cmd.Flags().GetString("owner-tag")
+		if ownerTag != "" {
+			if err := cloudflare.ValidateTag(ownerTag); err != nil {
+				return err
+			}
+		}
+		config.OwnerTag = ownerTag
+	}
+
+	// 预览模式
+	if dryRun {
+		fmt.Println("=== 预览模式 ===")
+		fmt.Printf("总共 %d 个域名\n", len(config.Zones))
+		if config.OwnerTag != "" {
+			fmt.Printf("所有权标签: %s\n", config.OwnerTag)
+		}
+		fmt.Println()
+		for i, zone := range config.Zones {
+			fmt.Printf("%d. %s (%d 条初始记录)\n", i+1, zone.Name, len(zone.Records))
+		}
+
+		if len(config.Settings) > 0 {
+			fmt.Println("\n设置基线:")
+			for _, id := range cloudflare.SupportedSettingIDs() {
+				if value, ok := config.Settings[id]; ok {
+					fmt.Printf("  %s = %s\n", id, value)
+				}
+			}
+		}
+		return nil
+	}
+
+	// 创建 Cloudflare 客户端
+	logger.Debug("创建 Cloudflare 客户端", "profile", profile)
+	client, err := cloudflare.NewClient(profile, logger.Logger)
+	if err != nil {
+		logger.Error("创建客户端失败", "error", err)
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
+	}
+	defer client.Close()
+
+	// 进度回调
+	progressCallback := func(msg string) {
+		logger.Info(msg)
+	}
+
+	results, err := client.OnboardZones(ctx, config, concurrency, progressCallback)
+	if err != nil {
+		logger.Error("批量接入失败", "error", err)
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
+	}
+
+	rows := onboardResultsToRows(results)
+
+	if reportFile != "" {
+		if err := writeOnboardReport(reportFile, rows); err != nil {
+			logger.Error("写入接入报告失败", "error", err)
+			return err
+		}
+		logger.Info("接入报告已写入", "file", reportFile)
+	}
+
+	if err := GetFormatter().Format(rows); err != nil {
+		logger.Error("格式化输出失败", "error", err)
+		return fmt.Errorf("格式化输出失败: %w", err)
+	}
+
+	failed := 0
+	for _, result := range results {
+		if !result.Success() {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("有 %d 个域名接入失败", failed)
+	}
+
+	return nil
+}
+
+// onboardResultsToRows 转换接入结果为输出格式
+func onboardResultsToRows(results []cloudflare.OnboardResult) []map[string]interface{} {
+	rows := make([]map[string]interface{}, 0, len(results))
+	for _, result := range results {
+		row := map[string]interface{}{
+			"name":             result.Zone,
+			"zone_id":          result.ZoneID,
+			"status":           result.Status,
+			"created":          result.Created,
+			"name_servers":     result.NameServers,
+			"records_created":  result.RecordsCreated,
+			"records_failed":   result.RecordsFailed,
+			"settings_changed": result.SettingsChanged,
+			"error":            "",
+		}
+		if result.Error != nil {
+			row["error"] = cloudflare.FormatError(result.Error)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// reportFormatter 根据报告文件扩展名返回对应的格式化器
+func reportFormatter(filename string, w io.Writer) (output.Formatter, error) {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".csv":
+		return output.NewCSVFormatter(w), nil
+	case ".json":
+		return output.NewJSONFormatter(w), nil
+	default:
+		return nil, fmt.Errorf("不支持的报告格式: %s (支持: .csv, .json)", filename)
+	}
+}
+
+// writeOnboardReport 将接入结果写入报告文件
+func writeOnboardReport(filename string, rows []map[string]interface{}) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("创建报告文件失败: %w", err)
+	}
+	defer file.Close()
+
+	formatter, err := reportFormatter(filename, file)
+	if err != nil {
+		return err
+	}
+
+	if err := formatter.Format(rows); err != nil {
+		return fmt.Errorf("写入报告文件失败: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWriteOnboardReport(t *testing.T) {
+	rows := []map[string]interface{}{
+		{
+			"name":         "example.com",
+			"status":       "pending",
+			"name_servers": []string{"ada.ns.cloudflare.com", "bob.ns.cloudflare.com"},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		file    string
+		want    string
+		wantErr bool
+	}{
+		{"CSV 报告", "report.csv", "ada.ns.cloudflare.com;bob.ns.cloudflare.com", false},
+		{"JSON 报告", "report.JSON", `"ada.ns.cloudflare.com"`, false},
+		{"不支持的格式", "report.txt", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.file)
+			err := writeOnboardReport(path, rows)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("writeOnboardReport() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(string(data), tt.want) {
+				t.Errorf("报告内容 = %s, 应包含 %s", data, tt.want)
+			}
+			if strings.HasSuffix(strings.ToLower(tt.file), ".json") && !json.Valid(data) {
+				t.Errorf("报告不是有效的 JSON: %s", data)
+			}
+		})
+	}
+}
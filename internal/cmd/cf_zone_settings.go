@@ -0,0 +1,382 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ado1t/cloudctl/internal/cloudflare"
+	"github.com/ado1t/cloudctl/internal/logger"
+)
+
+func init() {
+	// 添加 zone settings 子命令
+	cfZoneCmd.AddCommand(cfZoneSettingsCmd)
+	cfZoneSettingsCmd.AddCommand(cfZoneSettingsGetCmd)
+	cfZoneSettingsCmd.AddCommand(cfZoneSettingsSetCmd)
+	cfZoneSettingsCmd.AddCommand(cfZoneSettingsApplyCmd)
+
+	// zone settings set 命令参数
+	cfZoneSettingsSetCmd.Flags().Bool("dry-run", false, "预览模式，只显示差异")
+	cfZoneSettingsSetCmd.Flags().BoolP("yes", "y", false, "跳过确认提示")
+
+	// zone settings apply 命令参数
+	cfZoneSettingsApplyCmd.Flags().StringP("file", "f", "", "设置基线配置文件 (YAML)")
+	cfZoneSettingsApplyCmd.Flags().StringSlice("zones", nil, "应用基线的域名，多个域名用逗号分隔")
+	cfZoneSettingsApplyCmd.Flags().Bool("dry-run", false, "预览模式，只显示差异")
+	cfZoneSettingsApplyCmd.Flags().BoolP("yes", "y", false, "跳过确认提示")
+	cfZoneSettingsApplyCmd.MarkFlagRequired("file")
+	cfZoneSettingsApplyCmd.MarkFlagRequired("zones")
+}
+
+// cfZoneSettingsCmd 表示 zone settings 命令
+var cfZoneSettingsCmd = &cobra.Command{
+	Use:   "settings",
+	Short: "域名设置管理",
+	Long: `管理域名 (Zone) 的常用设置。
+
+支持的设置:
+  ssl                       off|flexible|full|strict
+  always_use_https          on|off
+  min_tls_version           1.0|1.1|1.2|1.3
+  tls_1_3                   on|off|zrt
+  http3                     on|off
+  brotli                    on|off
+  automatic_https_rewrites  on|off
+  cache_level               basic|simplified|aggressive`,
+}
+
+// cfZoneSettingsGetCmd 获取 Zone 设置
+var cfZoneSettingsGetCmd = &cobra.Command{
+	Use:   "get <domain> [setting...]",
+	Short: "查看域名设置",
+	Long: `查看域名 (Zone) 的设置，未指定设置时显示所有支持的设置。
+
+使用示例:
+  cloudctl cf zone settings get example.com
+  cloudctl cf zone settings get example.com ssl min_tls_version`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runZoneSettingsGet,
+}
+
+// runZoneSettingsGet 执行 zone settings get 命令
+func runZoneSettingsGet(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	domain := args[0]
+	ids := args[1:]
+
+	for _, id := range ids {
+		if _, ok := cloudflare.SupportedSettings[id]; !ok {
+			return fmt.Errorf("不支持的设置: %s (支持: %s)", id, strings.Join(cloudflare.SupportedSettingIDs(), ", "))
+		}
+	}
+
+	// 获取 profile 参数
+	profile, _ := cmd.Flags().GetString("profile")
+
+	// 创建 Cloudflare 客户端
+	logger.Debug("创建 Cloudflare 客户端", "profile", profile)
+	client, err := cloudflare.NewClient(profile, logger.Logger)
+	if err != nil {
+		logger.Error("创建客户端失败", "error", err)
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
+	}
+	defer client.Close()
+
+	// 获取 Zone ID
+	logger.Info("正在查找域名...", "domain", domain)
+	zone, err := client.GetZoneByName(ctx, domain)
+	if err != nil {
+		logger.Error("查找域名失败", "error", err)
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
+	}
+
+	settings, err := client.GetZoneSettings(ctx, zone.ID, ids)
+	if err != nil {
+		logger.Error("获取域名设置失败", "error", err)
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
+	}
+
+	if len(ids) == 0 {
+		ids = cloudflare.SupportedSettingIDs()
+	}
+	data := make([]map[string]interface{}, len(ids))
+	for i, id := range ids {
+		data[i] = map[string]interface{}{
+			"name":  id,
+			"value": settings[id],
+		}
+	}
+
+	if err := GetFormatter().Format(data); err != nil {
+		logger.Error("格式化输出失败", "error", err)
+		return fmt.Errorf("格式化输出失败: %w", err)
+	}
+
+	return nil
+}
+
+// cfZoneSettingsSetCmd 修改 Zone 设置
+var cfZoneSettingsSetCmd = &cobra.Command{
+	Use:   "set <domain> <setting=value>...",
+	Short: "修改域名设置",
+	Long: `修改域名 (Zone) 的一项或多项设置，已是目标值的设置会跳过。
+
+修改前会显示设置差异并要求确认。
+
+使用示例:
+  cloudctl cf zone settings set example.com ssl=strict --dry-run
+  cloudctl cf zone settings set example.com ssl=strict
+  cloudctl cf zone settings set example.com always_use_https=on min_tls_version=1.2 -y`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: runZoneSettingsSet,
+}
+
+// runZoneSettingsSet 执行 zone settings set 命令
+func runZoneSettingsSet(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	domain := args[0]
+
+	desired, err := parseSettingArgs(args[1:])
+	if err != nil {
+		return err
+	}
+
+	// 获取参数
+	profile, _ := cmd.Flags().GetString("profile")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	yes, _ := cmd.Flags().GetBool("yes")
+
+	// 创建 Cloudflare 客户端
+	logger.Debug("创建 Cloudflare 客户端", "profile", profile)
+	client, err := cloudflare.NewClient(profile, logger.Logger)
+	if err != nil {
+		logger.Error("创建客户端失败", "error", err)
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
+	}
+	defer client.Close()
+
+	// 获取 Zone ID
+	logger.Info("正在查找域名...", "domain", domain)
+	zone, err := client.GetZoneByName(ctx, domain)
+	if err != nil {
+		logger.Error("查找域名失败", "error", err)
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
+	}
+
+	changes, err := client.PlanZoneSettings(ctx, zone.ID, desired)
+	if err != nil {
+		logger.Error("获取域名设置失败", "error", err)
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
+	}
+
+	if len(changes) == 0 {
+		fmt.Println("设置已是目标值，无需修改")
+		return nil
+	}
+
+	// 显示设置差异
+	if err := GetFormatter().Format(settingChangesToRows(zone.Name, changes)); err != nil {
+		logger.Error("格式化输出失败", "error", err)
+		return fmt.Errorf("格式化输出失败: %w", err)
+	}
+
+	if dryRun {
+		fmt.Printf("\n预览模式：%d 项设置将被修改\n", len(changes))
+		return nil
+	}
+
+	if !yes {
+		fmt.Printf("\n确认修改 %s 的 %d 项设置?\n", zone.Name, len(changes))
+		fmt.Print("输入 'yes' 确认: ")
+
+		var confirm string
+		fmt.Scanln(&confirm)
+
+		if confirm != "yes" {
+			fmt.Println("已取消操作")
+			return nil
+		}
+	}
+
+	applied, err := client.ApplySettingChanges(ctx, zone.ID, changes)
+	if err != nil {
+		fmt.Printf("✗ %s (已修改 %d/%d 项)\n", zone.Name, applied, len(changes))
+		logger.Error("修改域名设置失败", "error", err)
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
+	}
+	fmt.Printf("✓ %s (修改 %d 项)\n", zone.Name, applied)
+
+	logger.Info("成功修改域名设置", "zone", zone.Name, "changes", len(changes))
+	return nil
+}
+
+// cfZoneSettingsApplyCmd 应用设置基线
+var cfZoneSettingsApplyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "将设置基线应用到多个域名",
+	Long: `将 YAML 基线文件中的设置应用到多个域名 (Zone)。
+
+应用前会显示每个域名的设置差异并要求确认，已符合基线的设置不会修改。
+
+基线文件示例 (zone-settings.yaml):
+  settings:
+    ssl: strict
+    always_use_https: "on"
+    min_tls_version: "1.2"
+    tls_1_3: "on"
+    http3: "on"
+    brotli: "on"
+    automatic_https_rewrites: "on"
+    cache_level: aggressive
+
+使用示例:
+  cloudctl cf zone settings apply -f zone-settings.yaml --zones example.com,test.com --dry-run
+  cloudctl cf zone settings apply -f zone-settings.yaml --zones example.com,test.com`,
+	RunE: runZoneSettingsApply,
+}
+
+// runZoneSettingsApply 执行 zone settings apply 命令
+func runZoneSettingsApply(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// 获取参数
+	profile, _ := cmd.Flags().GetString("profile")
+	file, _ := cmd.Flags().GetString("file")
+	domains, _ := cmd.Flags().GetStringSlice("zones")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	yes, _ := cmd.Flags().GetBool("yes")
+
+	baseline, err := cloudflare.LoadSettingsBaseline(file)
+	if err != nil {
+		return err
+	}
+
+	// 创建 Cloudflare 客户端
+	logger.Debug("创建 Cloudflare 客户端", "profile", profile)
+	client, err := cloudflare.NewClient(profile, logger.Logger)
+	if err != nil {
+		logger.Error("创建客户端失败", "error", err)
+		fmt.Fprintln(os.Stderr, cloudflare.FormatError(err))
+		os.Exit(cloudflare.GetExitCode(err))
+	}
+	defer client.Close()
+
+	// 计算每个域名的差异
+	type zonePlan struct {
+		zone    *cloudflare.ZoneInfo
+		changes []cloudflare.SettingChange
+	}
+	var plans []zonePlan
+	var rows []map[string]interface{}
+	for _, domain := range domains {
+		domain = strings.TrimSpace(domain)
+		if domain == "" {
+			continue
+		}
+
+		logger.Info("正在获取域名设置...", "domain", domain)
+		zone, err := client.GetZoneByName(ctx, domain)
+		if err != nil {
+			return fmt.Errorf("查找域名 %s 失败: %s", domain, cloudflare.FormatError(err))
+		}
+
+		changes, err := client.PlanZoneSettings(ctx, zone.ID, baseline.Settings)
+		if err != nil {
+			return fmt.Errorf("获取域名 %s 的设置失败: %s", domain, cloudflare.FormatError(err))
+		}
+
+		if len(changes) > 0 {
+			plans = append(plans, zonePlan{zone: zone, changes: changes})
+			rows = append(rows, settingChangesToRows(zone.Name, changes)...)
+		}
+	}
+
+	if len(plans) == 0 {
+		fmt.Println("所有域名的设置已符合基线")
+		return nil
+	}
+
+	if err := GetFormatter().Format(rows); err != nil {
+		logger.Error("格式化输出失败", "error", err)
+		return fmt.Errorf("格式化输出失败: %w", err)
+	}
+
+	if dryRun {
+		fmt.Printf("\n预览模式：%d 个域名共 %d 项设置将被修改\n", len(plans), len(rows))
+		return nil
+	}
+
+	if !yes {
+		fmt.Printf("\n确认修改 %d 个域名的 %d 项设置?\n", len(plans), len(rows))
+		fmt.Print("输入 'yes' 确认: ")
+
+		var confirm string
+		fmt.Scanln(&confirm)
+
+		if confirm != "yes" {
+			fmt.Println("已取消操作")
+			return nil
+		}
+	}
+
+	failed := 0
+	for _, plan := range plans {
+		applied, err := client.ApplySettingChanges(ctx, plan.zone.ID, plan.changes)
+		if err != nil {
+			fmt.Printf("✗ %s (已修改 %d/%d 项)\n  错误: %s\n", plan.zone.Name, applied, len(plan.changes), cloudflare.FormatError(err))
+			failed++
+			continue
+		}
+		fmt.Printf("✓ %s (修改 %d 项)\n", plan.zone.Name, applied)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("有 %d 个域名应用设置失败", failed)
+	}
+
+	return nil
+}
+
+// parseSettingArgs 解析 setting=value 格式的参数
+func parseSettingArgs(args []string) (map[string]string, error) {
+	settings := make(map[string]string, len(args))
+	for _, arg := range args {
+		id, value, ok := strings.Cut(arg, "=")
+		if !ok {
+			return nil, fmt.Errorf("无效的设置参数: %s (格式: setting=value)", arg)
+		}
+
+		id = strings.TrimSpace(id)
+		value = strings.TrimSpace(value)
+		if err := cloudflare.ValidateSetting(id, value); err != nil {
+			return nil, err
+		}
+		settings[id] = value
+	}
+	return settings, nil
+}
+
+// settingChangesToRows 转换设置变更为输出格式
+func settingChangesToRows(zone string, changes []cloudflare.SettingChange) []map[string]interface{} {
+	rows := make([]map[string]interface{}, len(changes))
+	for i, change := range changes {
+		rows[i] = map[string]interface{}{
+			"zone":    zone,
+			"name":    change.ID,
+			"current": change.Current,
+			"desired": change.Desired,
+		}
+	}
+	return rows
+}
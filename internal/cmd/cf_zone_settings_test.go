@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseSettingArgs(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "多项设置",
+			args: []string{"ssl=strict", " min_tls_version = 1.2 "},
+			want: map[string]string{"ssl": "strict", "min_tls_version": "1.2"},
+		},
+		{
+			name:    "缺少等号",
+			args:    []string{"ssl"},
+			wantErr: true,
+		},
+		{
+			name:    "不支持的设置",
+			args:    []string{"rocket_loader=on"},
+			wantErr: true,
+		},
+		{
+			name:    "无效的值",
+			args:    []string{"http3=enabled"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseSettingArgs(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseSettingArgs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseSettingArgs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
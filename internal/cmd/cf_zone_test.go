@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ado1t/cloudctl/internal/cloudflare"
+	"github.com/ado1t/cloudctl/internal/probe"
+)
+
+// startDelegationDNSServer 启动本地 UDP DNS 服务器，同时作为递归解析器和 com 的权威服务器:
+// com 的 NS 为 a.gtld.test (127.0.0.1)，不递归的查询在授权部分返回 referrals 中的委派
+func startDelegationDNSServer(t *testing.T, referrals map[string][]string) string {
+	t.Helper()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("启动 DNS 服务器失败: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	go func() {
+		buf := make([]byte, 1500)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			if resp := delegationDNSResponse(buf[:n], referrals); resp != nil {
+				conn.WriteTo(resp, addr)
+			}
+		}
+	}()
+
+	return conn.LocalAddr().String()
+}
+
+// delegationDNSResponse 根据查询报文构造应答报文，不存在的域名返回 NXDOMAIN
+func delegationDNSResponse(query []byte, referrals map[string][]string) []byte {
+	if len(query) < 12 {
+		return nil
+	}
+
+	var labels []string
+	off := 12
+	for off < len(query) && query[off] != 0 {
+		l := int(query[off])
+		if off+1+l > len(query) {
+			return nil
+		}
+		labels = append(labels, string(query[off+1:off+1+l]))
+		off += 1 + l
+	}
+	off += 5 // 结束符 + QTYPE + QCLASS
+	if off > len(query) {
+		return nil
+	}
+	name := strings.ToLower(strings.Join(labels, "."))
+	qtype := binary.BigEndian.Uint16(query[off-4:])
+	recursive := query[2]&0x01 != 0
+
+	var answers, authority [][]byte
+	found := true
+	switch {
+	case name == "com":
+		if qtype == 2 {
+			answers = append(answers, testDNSRR(2, testEncodeName("a.gtld.test")))
+		}
+	case name == "a.gtld.test":
+		if qtype == 1 {
+			answers = append(answers, testDNSRR(1, net.IPv4(127, 0, 0, 1).To4()))
+		}
+	case referrals[name] != nil && !recursive:
+		for _, host := range referrals[name] {
+			authority = append(authority, testDNSRR(2, testEncodeName(host)))
+		}
+	default:
+		found = false
+	}
+
+	resp := make([]byte, 12, 512)
+	copy(resp, query[:2])
+	flags := uint16(0x8180) // QR + RD + RA
+	if !found {
+		flags |= 3 // NXDOMAIN
+	}
+	binary.BigEndian.PutUint16(resp[2:], flags)
+	binary.BigEndian.PutUint16(resp[4:], 1)
+	binary.BigEndian.PutUint16(resp[6:], uint16(len(answers)))
+	binary.BigEndian.PutUint16(resp[8:], uint16(len(authority)))
+	resp = append(resp, query[12:off]...)
+	for _, rr := range append(answers, authority...) {
+		resp = append(resp, rr...)
+	}
+	return resp
+}
+
+// testDNSRR 编码 owner 为问题中域名的资源记录
+func testDNSRR(rrType uint16, rdata []byte) []byte {
+	rr := []byte{0xc0, 0x0c}                             // 指向问题中的域名
+	rr = append(rr, byte(rrType>>8), byte(rrType), 0, 1) // TYPE, IN
+	rr = append(rr, 0, 0, 0x0e, 0x10)                    // TTL 3600
+	rr = append(rr, byte(len(rdata)>>8), byte(len(rdata)))
+	return append(rr, rdata...)
+}
+
+// testEncodeName 编码 DNS 域名
+func testEncodeName(name string) []byte {
+	var b []byte
+	for _, label := range strings.Split(strings.TrimSuffix(name, "."), ".") {
+		b = append(b, byte(len(label)))
+		b = append(b, label...)
+	}
+	return append(b, 0)
+}
+
+func TestCheckZoneDelegation(t *testing.T) {
+	addr := startDelegationDNSServer(t, map[string][]string{
+		"example.com": {"Bob.NS.cloudflare.com.", "ada.ns.cloudflare.com."},
+		"test.com":    {"ns1.old-dns.net."},
+	})
+	_, port, _ := net.SplitHostPort(addr)
+	resolver := probe.NewResolver(addr, 2*time.Second)
+	nameServers := []string{"ada.ns.cloudflare.com", "bob.ns.cloudflare.com"}
+
+	tests := []struct {
+		name           string
+		zone           string
+		wantDelegation string
+		wantOK         bool
+		wantErr        bool
+	}{
+		{"委派正确", "example.com", cloudflare.DelegationCorrect, true, false},
+		{"委派到其他 Name Server", "test.com", cloudflare.DelegationIncorrect, false, false},
+		{"域名未委派", "missing.com", cloudflare.DelegationIncorrect, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			zone := &cloudflare.ZoneInfo{Name: tt.zone, Status: "pending", NameServers: nameServers}
+			row, ok := checkZoneDelegation(context.Background(), resolver, port, 2*time.Second, zone)
+			if ok != tt.wantOK {
+				t.Errorf("checkZoneDelegation() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if row["delegation"] != tt.wantDelegation {
+				t.Errorf("delegation = %v, want %s", row["delegation"], tt.wantDelegation)
+			}
+			if hasErr := row["error"] != ""; hasErr != tt.wantErr {
+				t.Errorf("error = %v, wantErr %v", row["error"], tt.wantErr)
+			}
+		})
+	}
+}
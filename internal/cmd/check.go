@@ -0,0 +1,376 @@
+package cmd
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ado1t/cloudctl/internal/aws"
+	"github.com/ado1t/cloudctl/internal/logger"
+	"github.com/ado1t/cloudctl/internal/probe"
+)
+
+var (
+	// Check TLS 参数
+	checkTLSProfile      string
+	checkTLSPort         int
+	checkTLSResolve      map[string]string
+	checkTLSTimeout      time.Duration
+	checkTLSCAFile       string
+	checkTLSARN          string
+	checkTLSDistribution string
+
+	// Check HTTP 参数
+	checkHTTPConfigFile   string
+	checkHTTPStatus       []int
+	checkHTTPRequireHTTPS bool
+	checkHTTPHeaders      []string
+	checkHTTPResolve      map[string]string
+	checkHTTPTimeout      time.Duration
+	checkHTTPConcurrency  int
+	checkHTTPCAFile       string
+)
+
+// checkCmd 表示 check 命令
+var checkCmd = &cobra.Command{
+	Use:   "check",
+	Short: "线上服务检查",
+	Long: `直接连接线上服务，检查实际返回的内容。
+
+可用命令:
+  cloudctl check tls   - 检查服务端返回的 TLS 证书
+  cloudctl check http  - 检查 CDN 域名的 HTTP 响应`,
+}
+
+// checkTLSCmd 检查服务端证书
+var checkTLSCmd = &cobra.Command{
+	Use:   "tls <host>...",
+	Short: "检查服务端返回的 TLS 证书",
+	Long: `使用 SNI 连接主机，报告服务端实际返回的证书链、签发者、SAN、到期时间、协议和加密套件。
+
+可以通过 --arn 或 --distribution 指定期望的证书，比对服务端返回的叶子证书是否一致。
+任一主机连接失败、证书验证失败或与期望证书不一致时命令返回非零退出码。
+
+使用示例:
+  # 检查域名证书
+  cloudctl check tls example.com www.example.com
+
+  # 与 ACM 证书比对
+  cloudctl check tls example.com --arn arn:aws:acm:us-east-1:123456789012:certificate/xxx
+
+  # 与分发配置的证书比对
+  cloudctl check tls cdn.example.com --distribution E1234567890ABC
+
+  # 直连指定节点（不经过 DNS 解析）
+  cloudctl check tls example.com --resolve example.com=203.0.113.10`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runCheckTLS,
+}
+
+// checkHTTPCmd 检查 HTTP 响应
+var checkHTTPCmd = &cobra.Command{
+	Use:   "http [url]...",
+	Short: "检查 CDN 域名的 HTTP 响应",
+	Long: `并发请求 URL，检查状态码、HTTP 到 HTTPS 的重定向和期望的响应头，并根据响应头识别 CDN（Cloudflare 或 CloudFront）。
+
+URL 未带协议时使用 https。使用 -f 时检查分发配置文件中所有的自定义域名。
+请求不会跟随重定向；任一 URL 检查未通过时命令返回非零退出码，可配合 -o json 在 CI 中使用。
+
+使用示例:
+  # 检查单个域名
+  cloudctl check http example.com
+
+  # 检查分发配置文件中的所有域名，并要求返回缓存状态头
+  cloudctl check http -f distributions.yaml --header x-cache
+
+  # 允许重定向状态码，并输出 JSON 报告
+  cloudctl check http https://example.com/old --status 200,301 -o json`,
+	RunE: runCheckHTTP,
+}
+
+func init() {
+	checkCmd.AddCommand(checkTLSCmd)
+	checkCmd.AddCommand(checkHTTPCmd)
+
+	// Check TLS 命令参数
+	checkTLSCmd.Flags().StringVarP(&checkTLSProfile, "profile", "p", "", "使用指定的 AWS profile（比对证书时使用）")
+	checkTLSCmd.Flags().IntVar(&checkTLSPort, "port", probe.DefaultTLSPort, "连接端口")
+	checkTLSCmd.Flags().StringToStringVar(&checkTLSResolve, "resolve", nil, "将主机名映射到指定地址，格式: host=ip 或 host=ip:port（可多次指定）")
+	checkTLSCmd.Flags().DurationVar(&checkTLSTimeout, "timeout", probe.DefaultTimeout, "连接超时时间")
+	checkTLSCmd.Flags().StringVar(&checkTLSCAFile, "ca-file", "", "验证证书使用的根证书文件（PEM），默认使用系统根证书")
+	checkTLSCmd.Flags().StringVar(&checkTLSARN, "arn", "", "期望的 ACM 证书 ARN")
+	checkTLSCmd.Flags().StringVar(&checkTLSDistribution, "distribution", "", "期望使用该 CloudFront 分发配置的证书")
+	checkTLSCmd.MarkFlagsMutuallyExclusive("arn", "distribution")
+
+	// Check HTTP 命令参数
+	checkHTTPCmd.Flags().StringVarP(&checkHTTPConfigFile, "file", "f", "", "分发配置文件路径，检查其中所有的自定义域名")
+	checkHTTPCmd.Flags().IntSliceVar(&checkHTTPStatus, "status", []int{200}, "允许的状态码（逗号分隔）")
+	checkHTTPCmd.Flags().BoolVar(&checkHTTPRequireHTTPS, "require-https", true, "要求 HTTP 请求重定向到 HTTPS")
+	checkHTTPCmd.Flags().StringArrayVar(&checkHTTPHeaders, "header", nil, "期望的响应头，格式: name 或 name=value（可多次指定）")
+	checkHTTPCmd.Flags().StringToStringVar(&checkHTTPResolve, "resolve", nil, "将主机名映射到指定地址，格式: host=ip、host=ip:port 或 host:port=ip:port（可多次指定）")
+	checkHTTPCmd.Flags().DurationVar(&checkHTTPTimeout, "timeout", probe.DefaultTimeout, "单个请求的超时时间")
+	checkHTTPCmd.Flags().IntVar(&checkHTTPConcurrency, "concurrency", probe.DefaultHTTPConcurrency, "并发数")
+	checkHTTPCmd.Flags().StringVar(&checkHTTPCAFile, "ca-file", "", "验证证书使用的根证书文件（PEM），默认使用系统根证书")
+}
+
+// runCheckTLS 执行 check tls 命令
+func runCheckTLS(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	opts := probe.TLSOptions{
+		Port:    checkTLSPort,
+		Resolve: checkTLSResolve,
+		Timeout: checkTLSTimeout,
+	}
+
+	pool, err := loadCertPool(checkTLSCAFile)
+	if err != nil {
+		return err
+	}
+	opts.RootCAs = pool
+
+	expected, err := expectedCertificate(ctx)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	now := time.Now()
+	data := make([]map[string]interface{}, 0, len(args))
+	for _, host := range args {
+		logger.Info("正在检查 TLS 证书...", "host", host)
+
+		// 所有行使用相同的列，表格和 CSV 按第一行确定列
+		row := map[string]interface{}{
+			"name":           host,
+			"status":         "",
+			"address":        "",
+			"protocol":       "",
+			"cipher":         "",
+			"verified":       "",
+			"chain":          "",
+			"issuer":         "",
+			"sans":           "",
+			"serial":         "",
+			"not_after":      "",
+			"days_remaining": "",
+			"match":          "",
+			"error":          "",
+		}
+
+		result, err := probe.ProbeTLS(ctx, host, opts)
+		if err != nil {
+			logger.Error("TLS 检查失败", "host", host, "error", err)
+			row["status"] = "ERROR"
+			row["error"] = err.Error()
+			data = append(data, row)
+			failed++
+			continue
+		}
+
+		ok := result.Verified
+		row["address"] = result.Address
+		row["protocol"] = result.Protocol
+		row["cipher"] = result.CipherSuite
+		row["verified"] = result.Verified
+		row["error"] = result.VerifyError
+
+		chain := make([]string, len(result.Chain))
+		for i, cert := range result.Chain {
+			chain[i] = cert.Subject
+		}
+		row["chain"] = chain
+
+		if leaf := result.Leaf(); leaf != nil {
+			row["issuer"] = leaf.Issuer
+			row["sans"] = leaf.SANs
+			row["serial"] = leaf.Serial
+			row["not_after"] = formatTime(leaf.NotAfter)
+			row["days_remaining"] = aws.DaysUntil(now, leaf.NotAfter)
+
+			if expected != nil {
+				match := probe.SameSerial(leaf.Serial, expected.Serial)
+				row["match"] = match
+				if !match {
+					ok = false
+				}
+			}
+		}
+
+		if ok {
+			row["status"] = "OK"
+		} else {
+			row["status"] = "FAILED"
+			failed++
+		}
+		data = append(data, row)
+	}
+
+	if err := GetFormatter().Format(data); err != nil {
+		return fmt.Errorf("格式化输出失败: %w", err)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("有 %d 个主机检查未通过", failed)
+	}
+
+	return nil
+}
+
+// expectedCertificate 获取 --arn 或 --distribution 指定的期望证书，未指定时返回 nil
+func expectedCertificate(ctx context.Context) (*aws.Certificate, error) {
+	if checkTLSARN == "" && checkTLSDistribution == "" {
+		return nil, nil
+	}
+
+	client, err := aws.NewClient(checkTLSProfile)
+	if err != nil {
+		return nil, fmt.Errorf("创建 AWS 客户端失败: %w", err)
+	}
+
+	arn := checkTLSARN
+	if checkTLSDistribution != "" {
+		dist, err := client.GetDistribution(ctx, checkTLSDistribution)
+		if err != nil {
+			return nil, fmt.Errorf("获取分发详情失败: %w", err)
+		}
+		if dist.CertificateARN == "" {
+			return nil, fmt.Errorf("分发 %s 未配置 ACM 证书", checkTLSDistribution)
+		}
+		arn = dist.CertificateARN
+	}
+
+	cert, err := client.GetCertificate(ctx, arn)
+	if err != nil {
+		return nil, fmt.Errorf("获取证书详情失败: %w", err)
+	}
+	if cert.Serial == "" {
+		return nil, fmt.Errorf("证书 %s 没有序列号（可能尚未签发）", arn)
+	}
+
+	return cert, nil
+}
+
+// runCheckHTTP 执行 check http 命令
+func runCheckHTTP(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	urls := append([]string{}, args...)
+	if checkHTTPConfigFile != "" {
+		aliases, err := loadDistributionAliases(checkHTTPConfigFile)
+		if err != nil {
+			return err
+		}
+		urls = append(urls, aliases...)
+	}
+	if len(urls) == 0 {
+		return fmt.Errorf("请指定要检查的 URL 或使用 -f 指定分发配置文件")
+	}
+
+	pool, err := loadCertPool(checkHTTPCAFile)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("正在检查 HTTP 响应...", "count", len(urls))
+
+	results := probe.CheckHTTP(ctx, urls, probe.HTTPOptions{
+		ExpectStatus:  checkHTTPStatus,
+		RequireHTTPS:  checkHTTPRequireHTTPS,
+		ExpectHeaders: checkHTTPHeaders,
+		Resolve:       checkHTTPResolve,
+		Timeout:       checkHTTPTimeout,
+		Concurrency:   checkHTTPConcurrency,
+		RootCAs:       pool,
+	})
+
+	failed := 0
+	data := make([]map[string]interface{}, len(results))
+	for i, r := range results {
+		row := map[string]interface{}{
+			"name":           r.URL,
+			"status":         "OK",
+			"status_code":    r.StatusCode,
+			"cdn":            r.CDN,
+			"cache":          cacheStatus(r.Headers),
+			"via":            r.Headers["via"],
+			"duration_ms":    r.Duration.Milliseconds(),
+			"failures":       r.Failures,
+			"https_redirect": "",
+		}
+		// 未检查 HTTPS 跳转时为空，所有行使用相同的列
+		if r.HTTPSRedirect != nil {
+			row["https_redirect"] = *r.HTTPSRedirect
+		}
+		if !r.OK() {
+			row["status"] = "FAILED"
+			failed++
+		}
+		data[i] = row
+	}
+
+	if err := GetFormatter().Format(data); err != nil {
+		return fmt.Errorf("格式化输出失败: %w", err)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("有 %d 个 URL 检查未通过", failed)
+	}
+
+	return nil
+}
+
+// loadDistributionAliases 读取分发配置文件中的所有自定义域名
+func loadDistributionAliases(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("读取配置文件失败: %w", err)
+	}
+
+	var config aws.DistributionsConfig
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("解析配置文件失败: %w", err)
+	}
+
+	var aliases []string
+	for _, dist := range config.Distributions {
+		aliases = append(aliases, dist.Aliases...)
+	}
+	if len(aliases) == 0 {
+		return nil, fmt.Errorf("配置文件中没有自定义域名")
+	}
+
+	return aliases, nil
+}
+
+// cacheStatus 返回 CDN 缓存状态响应头的值
+func cacheStatus(headers map[string]string) string {
+	if v := headers["cf-cache-status"]; v != "" {
+		return v
+	}
+	return headers["x-cache"]
+}
+
+// loadCertPool 读取根证书文件，path 为空时返回 nil 表示使用系统根证书
+func loadCertPool(path string) (*x509.CertPool, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("读取根证书文件失败: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("根证书文件中没有有效的证书")
+	}
+
+	return pool, nil
+}
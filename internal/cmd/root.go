@@ -168,7 +168,7 @@ func init() {
 	// 全局标志
 	rootCmd.PersistentFlags().StringP("config", "c", "", "配置文件路径 (默认: ~/.cloudctl/config.yaml)")
 	rootCmd.PersistentFlags().StringP("profile", "p", "", "使用的 profile")
-	rootCmd.PersistentFlags().StringP("output", "o", "table", "输出格式 (table|json)")
+	rootCmd.PersistentFlags().StringP("output", "o", "table", "输出格式 (table|json|csv)")
 	rootCmd.PersistentFlags().Bool("no-color", false, "禁用颜色输出")
 	rootCmd.PersistentFlags().StringP("log-level", "l", "", "日志级别 (debug|info|warn|error)")
 	rootCmd.PersistentFlags().CountP("verbose", "v", "详细程度级别 (-v: INFO, -vv: DEBUG, -vvv: DEBUG+源码)")
@@ -178,4 +178,6 @@ func init() {
 	rootCmd.AddCommand(cfCmd)
 	rootCmd.AddCommand(awsCmd)
 	rootCmd.AddCommand(configCmd)
+	rootCmd.AddCommand(checkCmd)
+	rootCmd.AddCommand(auditCmd)
 }
@@ -133,6 +133,13 @@ func getDefaultConfig() *Config {
 				InitialDelay: 1,
 				MaxDelay:     30,
 			},
+			Poll: PollConfig{
+				Interval:          5,
+				MaxInterval:       30,
+				Backoff:           1.5,
+				Timeout:           1800,
+				ValidationTimeout: 30,
+			},
 		},
 	}
 
@@ -183,6 +190,21 @@ func applyDefaults(cfg *Config) {
 	if cfg.API.Retry.MaxDelay == 0 {
 		cfg.API.Retry.MaxDelay = 30
 	}
+	if cfg.API.Poll.Interval == 0 {
+		cfg.API.Poll.Interval = 5
+	}
+	if cfg.API.Poll.MaxInterval == 0 {
+		cfg.API.Poll.MaxInterval = 30
+	}
+	if cfg.API.Poll.Backoff == 0 {
+		cfg.API.Poll.Backoff = 1.5
+	}
+	if cfg.API.Poll.Timeout == 0 {
+		cfg.API.Poll.Timeout = 1800
+	}
+	if cfg.API.Poll.ValidationTimeout == 0 {
+		cfg.API.Poll.ValidationTimeout = 30
+	}
 
 	// 默认 profile
 	if cfg.DefaultProfile.Cloudflare == "" {
@@ -301,6 +323,7 @@ func Validate(cfg *Config) error {
 	validOutputFormats := map[string]bool{
 		"table": true,
 		"json":  true,
+		"csv":   true,
 	}
 	if !validOutputFormats[cfg.Output.Format] {
 		return fmt.Errorf("无效的输出格式: %s", cfg.Output.Format)
@@ -324,6 +347,14 @@ func Validate(cfg *Config) error {
 		}
 	}
 
+	// 验证轮询配置
+	if cfg.API.Poll.Interval < 0 || cfg.API.Poll.MaxInterval < 0 || cfg.API.Poll.Timeout < 0 || cfg.API.Poll.ValidationTimeout < 0 {
+		return fmt.Errorf("轮询间隔和超时时间不能为负数")
+	}
+	if cfg.API.Poll.Backoff < 0 {
+		return fmt.Errorf("轮询间隔放大倍数不能为负数")
+	}
+
 	return nil
 }
 
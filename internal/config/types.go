@@ -23,9 +23,10 @@ type CFProfile struct {
 
 // AWSProfile AWS profile 配置
 type AWSProfile struct {
-	AccessKeyID     string `mapstructure:"access_key_id" yaml:"access_key_id"`
-	SecretAccessKey string `mapstructure:"secret_access_key" yaml:"secret_access_key"`
-	Region          string `mapstructure:"region" yaml:"region"`
+	AccessKeyID     string   `mapstructure:"access_key_id" yaml:"access_key_id"`
+	SecretAccessKey string   `mapstructure:"secret_access_key" yaml:"secret_access_key"`
+	Region          string   `mapstructure:"region" yaml:"region"`
+	Regions         []string `mapstructure:"regions" yaml:"regions"` // 多区域查询时使用的区域列表
 }
 
 // LogConfig 日志配置
@@ -46,6 +47,7 @@ type OutputConfig struct {
 type APIConfig struct {
 	Timeout int         `mapstructure:"timeout" yaml:"timeout"` // 超时时间（秒）
 	Retry   RetryConfig `mapstructure:"retry" yaml:"retry"`     // 重试配置
+	Poll    PollConfig  `mapstructure:"poll" yaml:"poll"`       // 轮询等待配置
 }
 
 // RetryConfig 重试配置
@@ -55,3 +57,12 @@ type RetryConfig struct {
 	InitialDelay int  `mapstructure:"initial_delay" yaml:"initial_delay"` // 初始延迟（秒）
 	MaxDelay     int  `mapstructure:"max_delay" yaml:"max_delay"`         // 最大延迟（秒）
 }
+
+// PollConfig 轮询等待配置（证书签发、分发部署、缓存失效等）
+type PollConfig struct {
+	Interval          int     `mapstructure:"interval" yaml:"interval"`                     // 初始轮询间隔（秒）
+	MaxInterval       int     `mapstructure:"max_interval" yaml:"max_interval"`             // 最大轮询间隔（秒）
+	Backoff           float64 `mapstructure:"backoff" yaml:"backoff"`                       // 间隔放大倍数
+	Timeout           int     `mapstructure:"timeout" yaml:"timeout"`                       // 总超时时间（秒）
+	ValidationTimeout int     `mapstructure:"validation_timeout" yaml:"validation_timeout"` // 等待证书验证记录生成的超时时间（秒）
+}
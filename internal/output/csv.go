@@ -0,0 +1,126 @@
+package output
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// CSVFormatter CSV 格式化器
+type CSVFormatter struct {
+	writer io.Writer
+}
+
+// NewCSVFormatter 创建 CSV 格式化器
+func NewCSVFormatter(writer io.Writer) *CSVFormatter {
+	return &CSVFormatter{
+		writer: writer,
+	}
+}
+
+// Format 实现 Formatter 接口
+func (f *CSVFormatter) Format(data interface{}) error {
+	// 处理不同类型的数据
+	switch v := data.(type) {
+	case []map[string]interface{}:
+		return f.formatMapSlice(v)
+	case map[string]interface{}:
+		return f.formatMap(v)
+	case []interface{}:
+		return f.formatInterfaceSlice(v)
+	default:
+		return fmt.Errorf("CSV 格式不支持该数据类型: %T", data)
+	}
+}
+
+// formatMapSlice 格式化 map 切片，第一行为表头
+func (f *CSVFormatter) formatMapSlice(data []map[string]interface{}) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	// 提取表头并使用与表格相同的列顺序
+	headers := make([]string, 0, len(data[0]))
+	for key := range data[0] {
+		headers = append(headers, key)
+	}
+	sortHeaders(headers)
+
+	records := make([][]string, 0, len(data)+1)
+	records = append(records, headers)
+	for _, row := range data {
+		record := make([]string, len(headers))
+		for i, header := range headers {
+			record[i] = csvValue(row[header])
+		}
+		records = append(records, record)
+	}
+
+	return f.write(records)
+}
+
+// formatMap 格式化单个 map（键值对形式）
+func (f *CSVFormatter) formatMap(data map[string]interface{}) error {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	records := make([][]string, 0, len(data)+1)
+	records = append(records, []string{"key", "value"})
+	for _, key := range keys {
+		records = append(records, []string{key, csvValue(data[key])})
+	}
+
+	return f.write(records)
+}
+
+// formatInterfaceSlice 格式化 interface{} 切片
+func (f *CSVFormatter) formatInterfaceSlice(data []interface{}) error {
+	// 尝试转换为 map 切片
+	mapSlice := make([]map[string]interface{}, 0, len(data))
+	for _, item := range data {
+		if m, ok := item.(map[string]interface{}); ok {
+			mapSlice = append(mapSlice, m)
+		}
+	}
+
+	if len(mapSlice) > 0 {
+		return f.formatMapSlice(mapSlice)
+	}
+
+	// 简单列表输出
+	records := make([][]string, 0, len(data)+1)
+	records = append(records, []string{"value"})
+	for _, item := range data {
+		records = append(records, []string{csvValue(item)})
+	}
+
+	return f.write(records)
+}
+
+// write 写出所有记录
+func (f *CSVFormatter) write(records [][]string) error {
+	w := csv.NewWriter(f.writer)
+	if err := w.WriteAll(records); err != nil {
+		return fmt.Errorf("写入 CSV 失败: %w", err)
+	}
+	return nil
+}
+
+// csvValue 将单元格的值转换为字符串，字符串切片使用分号连接
+func csvValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []string:
+		return strings.Join(v, ";")
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
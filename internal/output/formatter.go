@@ -16,6 +16,8 @@ const (
 	FormatYAML Format = "yaml"
 	// FormatText 纯文本格式
 	FormatText Format = "text"
+	// FormatCSV CSV 格式
+	FormatCSV Format = "csv"
 )
 
 // Formatter 定义输出格式化接口
@@ -49,6 +51,8 @@ func New(cfg Config) Formatter {
 		return NewTableFormatter(cfg.Writer, !cfg.NoColor)
 	case FormatText:
 		return NewTextFormatter(cfg.Writer, !cfg.NoColor)
+	case FormatCSV:
+		return NewCSVFormatter(cfg.Writer)
 	default:
 		return NewTableFormatter(cfg.Writer, !cfg.NoColor)
 	}
@@ -65,7 +69,7 @@ func (f *NullFormatter) Format(data interface{}) error {
 // IsValidFormat 检查格式是否有效
 func IsValidFormat(format string) bool {
 	switch Format(format) {
-	case FormatTable, FormatJSON, FormatYAML, FormatText:
+	case FormatTable, FormatJSON, FormatYAML, FormatText, FormatCSV:
 		return true
 	default:
 		return false
@@ -81,6 +85,8 @@ func ParseFormat(format string) Format {
 		return FormatYAML
 	case FormatText:
 		return FormatText
+	case FormatCSV:
+		return FormatCSV
 	case FormatTable:
 		return FormatTable
 	default:
@@ -68,6 +68,7 @@ func TestIsValidFormat(t *testing.T) {
 		{"json", true},
 		{"yaml", true},
 		{"text", true},
+		{"csv", true},
 		{"invalid", false},
 		{"", false},
 	}
@@ -91,6 +92,7 @@ func TestParseFormat(t *testing.T) {
 		{"table", FormatTable},
 		{"yaml", FormatYAML},
 		{"text", FormatText},
+		{"csv", FormatCSV},
 		{"invalid", FormatTable}, // 默认值
 	}
 
@@ -300,3 +302,38 @@ func TestTableFormatterPrintMethods(t *testing.T) {
 		t.Error("输出应包含错误消息")
 	}
 }
+
+func TestCSVFormatter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	formatter := NewCSVFormatter(buf)
+
+	data := []map[string]interface{}{
+		{"name": "example.com", "status": "ISSUED", "sans": []string{"a.example.com", "b.example.com"}},
+		{"name": "test, inc", "status": "EXPIRED", "sans": []string{}},
+	}
+
+	if err := formatter.Format(data); err != nil {
+		t.Fatalf("Format() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("输出行数 = %d, want 3", len(lines))
+	}
+	if lines[0] != "name,status,sans" {
+		t.Errorf("表头 = %q, want %q", lines[0], "name,status,sans")
+	}
+	if lines[1] != "example.com,ISSUED,a.example.com;b.example.com" {
+		t.Errorf("第一行 = %q", lines[1])
+	}
+	if lines[2] != `"test, inc",EXPIRED,` {
+		t.Errorf("第二行 = %q", lines[2])
+	}
+}
+
+func TestCSVFormatterUnsupported(t *testing.T) {
+	formatter := NewCSVFormatter(&bytes.Buffer{})
+	if err := formatter.Format(42); err == nil {
+		t.Error("不支持的数据类型应返回错误")
+	}
+}
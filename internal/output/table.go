@@ -54,33 +54,7 @@ func (f *TableFormatter) formatMapSlice(data []map[string]interface{}) error {
 	}
 
 	// 使用自定义排序，常见字段优先
-	sort.Slice(headers, func(i, j int) bool {
-		priority := map[string]int{
-			"name":        1,
-			"status":      2,
-			"id":          3,
-			"zone_id":     3,
-			"created_on":  4,
-			"modified_on": 5,
-		}
-
-		pi, oki := priority[headers[i]]
-		pj, okj := priority[headers[j]]
-
-		// 如果都有优先级，按优先级排序
-		if oki && okj {
-			return pi < pj
-		}
-		// 如果只有一个有优先级，有优先级的排前面
-		if oki {
-			return true
-		}
-		if okj {
-			return false
-		}
-		// 都没有优先级，按字母排序
-		return headers[i] < headers[j]
-	})
+	sortHeaders(headers)
 
 	// 计算每列的最大宽度
 	colWidths := make([]int, len(headers))
@@ -107,6 +81,37 @@ func (f *TableFormatter) formatMapSlice(data []map[string]interface{}) error {
 	return nil
 }
 
+// sortHeaders 按优先级排序表头，常见字段优先，其余按字母排序
+func sortHeaders(headers []string) {
+	priority := map[string]int{
+		"name":        1,
+		"status":      2,
+		"id":          3,
+		"zone_id":     3,
+		"created_on":  4,
+		"modified_on": 5,
+	}
+
+	sort.Slice(headers, func(i, j int) bool {
+		pi, oki := priority[headers[i]]
+		pj, okj := priority[headers[j]]
+
+		// 如果都有优先级，按优先级排序
+		if oki && okj {
+			return pi < pj
+		}
+		// 如果只有一个有优先级，有优先级的排前面
+		if oki {
+			return true
+		}
+		if okj {
+			return false
+		}
+		// 都没有优先级，按字母排序
+		return headers[i] < headers[j]
+	})
+}
+
 // formatMap 格式化单个 map（键值对形式）
 func (f *TableFormatter) formatMap(data map[string]interface{}) error {
 	headers := []string{"Key", "Value"}
@@ -0,0 +1,317 @@
+package probe
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultDNSPort 默认 DNS 端口
+const DefaultDNSPort = "53"
+
+// NewResolver 创建使用指定 DNS 服务器的解析器
+// address 为空时使用系统解析器；未带端口时使用 53 端口
+func NewResolver(address string, timeout time.Duration) *net.Resolver {
+	if address == "" {
+		return net.DefaultResolver
+	}
+
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(address, DefaultDNSPort)
+	}
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	dialer := &net.Dialer{Timeout: timeout}
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			return dialer.DialContext(ctx, network, address)
+		},
+	}
+}
+
+// LookupNS 查询域名的 NS 记录，结果已规范化（小写、去掉末尾的点）并排序
+func LookupNS(ctx context.Context, resolver *net.Resolver, domain string) ([]string, error) {
+	records, err := resolver.LookupNS(ctx, domain)
+	if err != nil {
+		return nil, fmt.Errorf("查询 %s 的 NS 记录失败: %w", domain, err)
+	}
+
+	hosts := make([]string, 0, len(records))
+	for _, record := range records {
+		hosts = append(hosts, NormalizeHost(record.Host))
+	}
+	sort.Strings(hosts)
+
+	return hosts, nil
+}
+
+// DNS 报文常量
+const (
+	dnsTypeNS      = 2
+	dnsClassIN     = 1
+	dnsHeaderLen   = 12
+	dnsMaxUDPSize  = 4096
+	dnsFlagQR      = 0x8000
+	dnsFlagTC      = 0x0200
+	dnsRcodeMask   = 0x000f
+	dnsRcodeNXName = 3
+)
+
+// LookupDelegation 查询上级域名权威服务器返回的 NS 委派
+// 先通过 resolver 找到上级域名（如 com）的权威服务器及其地址，再向这些服务器发送不递归的 NS 查询，
+// 读取引荐（referral）中的 NS 记录。与递归查询域名自身的 NS 不同，结果不受缓存影响，
+// 并且反映注册商处实际配置的委派。port 为空时使用 53 端口
+func LookupDelegation(ctx context.Context, resolver *net.Resolver, domain, port string) ([]string, error) {
+	domain = NormalizeHost(domain)
+	if port == "" {
+		port = DefaultDNSPort
+	}
+
+	parent, servers, err := parentNameServers(ctx, resolver, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastErr error
+	for _, server := range servers {
+		addrs, err := resolver.LookupHost(ctx, server)
+		if err != nil {
+			lastErr = fmt.Errorf("解析 %s 的权威服务器 %s 失败: %w", parent, server, err)
+			continue
+		}
+
+		for _, addr := range addrs {
+			hosts, err := queryNS(ctx, net.JoinHostPort(addr, port), domain)
+			if err == nil {
+				return hosts, nil
+			}
+			lastErr = fmt.Errorf("向 %s (%s) 查询 %s 的委派失败: %w", server, addr, domain, err)
+			// 域名不存在时其他服务器的结果相同
+			if errors.Is(err, errNXDomain) {
+				return nil, lastErr
+			}
+		}
+	}
+
+	if lastErr == nil {
+		lastErr = fmt.Errorf("%s 没有可用的权威服务器", parent)
+	}
+	return nil, lastErr
+}
+
+// errNXDomain 权威服务器返回域名不存在
+var errNXDomain = errors.New("域名不存在 (NXDOMAIN)")
+
+// parentNameServers 从上一级域名开始向上查找有 NS 记录的上级域名，返回该域名及其权威服务器
+func parentNameServers(ctx context.Context, resolver *net.Resolver, domain string) (string, []string, error) {
+	_, parent, ok := strings.Cut(domain, ".")
+	for ok && parent != "" {
+		servers, err := LookupNS(ctx, resolver, parent)
+		if err == nil && len(servers) > 0 {
+			return parent, servers, nil
+		}
+
+		// 没有 NS 记录的中间域名（不是 zone 边界）继续向上查找，其他错误直接返回
+		var dnsErr *net.DNSError
+		if err != nil && !(errors.As(err, &dnsErr) && dnsErr.IsNotFound) {
+			return "", nil, err
+		}
+		_, parent, ok = strings.Cut(parent, ".")
+	}
+
+	return "", nil, fmt.Errorf("找不到 %s 的上级域名权威服务器", domain)
+}
+
+// queryNS 向指定服务器发送不递归的 NS 查询，返回应答或授权部分中该域名的 NS 记录
+// UDP 应答被截断时使用 TCP 重试
+func queryNS(ctx context.Context, server, domain string) ([]string, error) {
+	id := uint16(rand.UintN(1 << 16))
+	query := buildNSQuery(id, domain)
+
+	resp, err := exchange(ctx, "udp", server, query)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp) >= 4 && binary.BigEndian.Uint16(resp[2:])&dnsFlagTC != 0 {
+		if resp, err = exchange(ctx, "tcp", server, query); err != nil {
+			return nil, err
+		}
+	}
+
+	return parseNSResponse(resp, id, domain)
+}
+
+// exchange 发送 DNS 查询并读取应答，TCP 报文带两字节长度前缀
+func exchange(ctx context.Context, network, server string, query []byte) ([]byte, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, network, server)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(DefaultTimeout)
+	}
+	conn.SetDeadline(deadline)
+
+	if network == "tcp" {
+		msg := make([]byte, 2, 2+len(query))
+		binary.BigEndian.PutUint16(msg, uint16(len(query)))
+		if _, err := conn.Write(append(msg, query...)); err != nil {
+			return nil, err
+		}
+
+		var length [2]byte
+		if _, err := io.ReadFull(conn, length[:]); err != nil {
+			return nil, err
+		}
+		resp := make([]byte, binary.BigEndian.Uint16(length[:]))
+		if _, err := io.ReadFull(conn, resp); err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}
+
+	if _, err := conn.Write(query); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, dnsMaxUDPSize)
+	n, err := conn.Read(buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf[:n], nil
+}
+
+// buildNSQuery 构造不递归 (RD=0) 的 NS 查询报文
+func buildNSQuery(id uint16, domain string) []byte {
+	msg := make([]byte, dnsHeaderLen, 512)
+	binary.BigEndian.PutUint16(msg[0:], id)
+	binary.BigEndian.PutUint16(msg[4:], 1) // QDCOUNT
+	for _, label := range strings.Split(domain, ".") {
+		msg = append(msg, byte(len(label)))
+		msg = append(msg, label...)
+	}
+	msg = append(msg, 0)
+	msg = binary.BigEndian.AppendUint16(msg, dnsTypeNS)
+	return binary.BigEndian.AppendUint16(msg, dnsClassIN)
+}
+
+// parseNSResponse 解析应答报文，返回应答和授权部分中 domain 的 NS 记录（规范化并排序）
+func parseNSResponse(msg []byte, id uint16, domain string) ([]string, error) {
+	if len(msg) < dnsHeaderLen {
+		return nil, fmt.Errorf("应答报文过短")
+	}
+	if binary.BigEndian.Uint16(msg[0:]) != id {
+		return nil, fmt.Errorf("应答 ID 不匹配")
+	}
+
+	flags := binary.BigEndian.Uint16(msg[2:])
+	if flags&dnsFlagQR == 0 {
+		return nil, fmt.Errorf("收到的不是应答报文")
+	}
+	switch rcode := flags & dnsRcodeMask; rcode {
+	case 0:
+	case dnsRcodeNXName:
+		return nil, errNXDomain
+	default:
+		return nil, fmt.Errorf("服务器返回错误码 %d", rcode)
+	}
+
+	qdCount := int(binary.BigEndian.Uint16(msg[4:]))
+	rrCount := int(binary.BigEndian.Uint16(msg[6:])) + int(binary.BigEndian.Uint16(msg[8:]))
+
+	off := dnsHeaderLen
+	for i := 0; i < qdCount; i++ {
+		_, next, err := readName(msg, off)
+		if err != nil {
+			return nil, err
+		}
+		off = next + 4
+	}
+
+	var hosts []string
+	for i := 0; i < rrCount; i++ {
+		name, next, err := readName(msg, off)
+		if err != nil {
+			return nil, err
+		}
+		if next+10 > len(msg) {
+			return nil, fmt.Errorf("资源记录被截断")
+		}
+		rrType := binary.BigEndian.Uint16(msg[next:])
+		rdLen := int(binary.BigEndian.Uint16(msg[next+8:]))
+		rdata := next + 10
+		if rdata+rdLen > len(msg) {
+			return nil, fmt.Errorf("资源记录被截断")
+		}
+
+		if rrType == dnsTypeNS && NormalizeHost(name) == domain {
+			host, _, err := readName(msg, rdata)
+			if err != nil {
+				return nil, err
+			}
+			hosts = append(hosts, NormalizeHost(host))
+		}
+		off = rdata + rdLen
+	}
+
+	if len(hosts) == 0 {
+		return nil, fmt.Errorf("应答中没有 %s 的 NS 委派", domain)
+	}
+	sort.Strings(hosts)
+	return hosts, nil
+}
+
+// readName 读取 off 处的域名（支持压缩指针），返回域名和域名之后的偏移
+func readName(msg []byte, off int) (string, int, error) {
+	var labels []string
+	next := -1
+	for jumps := 0; ; {
+		if off >= len(msg) {
+			return "", 0, fmt.Errorf("域名被截断")
+		}
+		l := int(msg[off])
+		switch {
+		case l == 0:
+			if next < 0 {
+				next = off + 1
+			}
+			return strings.Join(labels, "."), next, nil
+		case l&0xc0 == 0xc0:
+			if off+1 >= len(msg) {
+				return "", 0, fmt.Errorf("域名被截断")
+			}
+			if jumps++; jumps > 16 {
+				return "", 0, fmt.Errorf("域名压缩指针过多")
+			}
+			if next < 0 {
+				next = off + 2
+			}
+			off = int(binary.BigEndian.Uint16(msg[off:]) & 0x3fff)
+		default:
+			if off+1+l > len(msg) {
+				return "", 0, fmt.Errorf("域名被截断")
+			}
+			labels = append(labels, string(msg[off+1:off+1+l]))
+			off += 1 + l
+		}
+	}
+}
+
+// NormalizeHost 规范化主机名：小写并去掉末尾的点
+func NormalizeHost(host string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(host)), ".")
+}
@@ -0,0 +1,229 @@
+package probe
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// dnsRecords 本地 DNS 服务器的数据
+type dnsRecords struct {
+	// ns 域名到 NS 主机名的映射，在应答部分返回
+	ns map[string][]string
+	// addrs 主机名到 IPv4 地址的映射
+	addrs map[string]string
+	// referrals 域名到委派 NS 主机名的映射，不递归的查询在授权部分返回（上级域名服务器的引荐）
+	referrals map[string][]string
+}
+
+// startDNSServer 启动本地 UDP DNS 服务器，不存在的域名返回 NXDOMAIN
+func startDNSServer(t *testing.T, records dnsRecords) string {
+	t.Helper()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("启动 DNS 服务器失败: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	go func() {
+		buf := make([]byte, 1500)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			if resp := dnsResponse(buf[:n], records); resp != nil {
+				conn.WriteTo(resp, addr)
+			}
+		}
+	}()
+
+	return conn.LocalAddr().String()
+}
+
+// dnsResponse 根据查询报文构造应答报文
+func dnsResponse(query []byte, records dnsRecords) []byte {
+	if len(query) < 12 {
+		return nil
+	}
+
+	// 解析问题中的域名
+	var labels []string
+	off := 12
+	for off < len(query) && query[off] != 0 {
+		l := int(query[off])
+		if off+1+l > len(query) {
+			return nil
+		}
+		labels = append(labels, string(query[off+1:off+1+l]))
+		off += 1 + l
+	}
+	off += 5 // 结束符 + QTYPE + QCLASS
+	if off > len(query) {
+		return nil
+	}
+	name := strings.ToLower(strings.Join(labels, "."))
+	qtype := binary.BigEndian.Uint16(query[off-4:])
+
+	// 应答部分和授权部分的记录，owner 均为问题中的域名
+	var answers, authority [][]byte
+	hosts, isNS := records.ns[name]
+	addr, isAddr := records.addrs[name]
+	referral, isReferral := records.referrals[name]
+	recursive := query[2]&0x01 != 0
+	switch {
+	case isReferral && !recursive:
+		for _, host := range referral {
+			authority = append(authority, dnsRR(2, encodeName(host)))
+		}
+	case isNS && qtype == 2:
+		for _, host := range hosts {
+			answers = append(answers, dnsRR(2, encodeName(host)))
+		}
+	case isAddr && qtype == 1:
+		answers = append(answers, dnsRR(1, net.ParseIP(addr).To4()))
+	}
+
+	resp := make([]byte, 12, 512)
+	copy(resp, query[:2])
+	flags := uint16(0x8180) // QR + RD + RA
+	if !isNS && !isAddr && !isReferral {
+		flags |= 3 // NXDOMAIN
+	}
+	binary.BigEndian.PutUint16(resp[2:], flags)
+	binary.BigEndian.PutUint16(resp[4:], 1)
+	binary.BigEndian.PutUint16(resp[6:], uint16(len(answers)))
+	binary.BigEndian.PutUint16(resp[8:], uint16(len(authority)))
+	resp = append(resp, query[12:off]...)
+
+	for _, rr := range append(answers, authority...) {
+		resp = append(resp, rr...)
+	}
+
+	return resp
+}
+
+// dnsRR 编码 owner 为问题中域名的资源记录
+func dnsRR(rrType uint16, rdata []byte) []byte {
+	rr := []byte{0xc0, 0x0c}                             // 指向问题中的域名
+	rr = append(rr, byte(rrType>>8), byte(rrType), 0, 1) // TYPE, IN
+	rr = append(rr, 0, 0, 0x0e, 0x10)                    // TTL 3600
+	rr = append(rr, byte(len(rdata)>>8), byte(len(rdata)))
+	return append(rr, rdata...)
+}
+
+// encodeName 编码 DNS 域名
+func encodeName(name string) []byte {
+	var b []byte
+	for _, label := range strings.Split(strings.TrimSuffix(name, "."), ".") {
+		b = append(b, byte(len(label)))
+		b = append(b, label...)
+	}
+	return append(b, 0)
+}
+
+func TestLookupNS(t *testing.T) {
+	addr := startDNSServer(t, dnsRecords{ns: map[string][]string{
+		"example.com": {"Bob.NS.cloudflare.com.", "ada.ns.cloudflare.com."},
+	}})
+	resolver := NewResolver(addr, 2*time.Second)
+
+	tests := []struct {
+		name    string
+		domain  string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:   "返回规范化并排序的 NS",
+			domain: "example.com",
+			want:   []string{"ada.ns.cloudflare.com", "bob.ns.cloudflare.com"},
+		},
+		{
+			name:    "域名不存在",
+			domain:  "missing.example.org",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LookupNS(context.Background(), resolver, tt.domain)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LookupNS() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LookupNS() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLookupDelegation(t *testing.T) {
+	// 本地服务器同时作为递归解析器和 com 的权威服务器
+	// 域名自身的 NS 仍是旧值，委派已修改为 Cloudflare
+	addr := startDNSServer(t, dnsRecords{
+		ns: map[string][]string{
+			"com":         {"a.gtld.test."},
+			"example.com": {"ns1.old-dns.net."},
+		},
+		addrs: map[string]string{
+			"a.gtld.test": "127.0.0.1",
+		},
+		referrals: map[string][]string{
+			"example.com":     {"Bob.NS.cloudflare.com.", "ada.ns.cloudflare.com."},
+			"sub.example.com": {"ns1.old-dns.net."},
+		},
+	})
+	_, port, _ := net.SplitHostPort(addr)
+	resolver := NewResolver(addr, 2*time.Second)
+
+	tests := []struct {
+		name    string
+		domain  string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:   "返回上级域名服务器引荐中的 NS",
+			domain: "Example.COM.",
+			want:   []string{"ada.ns.cloudflare.com", "bob.ns.cloudflare.com"},
+		},
+		{
+			name:    "域名未委派",
+			domain:  "missing.com",
+			wantErr: true,
+		},
+		{
+			name:    "找不到上级域名",
+			domain:  "example.org",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LookupDelegation(context.Background(), resolver, tt.domain, port)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LookupDelegation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LookupDelegation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewResolver(t *testing.T) {
+	if NewResolver("", 0) != net.DefaultResolver {
+		t.Error("NewResolver(\"\") 应返回系统解析器")
+	}
+	if r := NewResolver("127.0.0.1", 0); r == net.DefaultResolver || !r.PreferGo {
+		t.Error("NewResolver() 应返回使用指定服务器的解析器")
+	}
+}
@@ -0,0 +1,304 @@
+package probe
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CDN 名称
+const (
+	CDNCloudflare = "cloudflare"
+	CDNCloudFront = "cloudfront"
+	CDNUnknown    = "unknown"
+)
+
+// DefaultHTTPConcurrency 默认并发数
+const DefaultHTTPConcurrency = 10
+
+// reportedHeaders 结果中记录的响应头
+var reportedHeaders = []string{
+	"cf-cache-status",
+	"cf-ray",
+	"x-cache",
+	"x-amz-cf-id",
+	"x-amz-cf-pop",
+	"via",
+	"server",
+	"location",
+}
+
+// HTTPOptions HTTP 检查选项
+type HTTPOptions struct {
+	// ExpectStatus 允许的状态码，为空时只允许 200
+	ExpectStatus []int
+	// RequireHTTPS 是否要求 HTTP 请求重定向到 HTTPS
+	RequireHTTPS bool
+	// ExpectHeaders 期望的响应头，格式为 name 或 name=value，value 按不区分大小写的子串匹配
+	ExpectHeaders []string
+	// Resolve 主机名到连接地址的映射，详见 dialAddress
+	Resolve map[string]string
+	// Timeout 单个请求的超时时间
+	Timeout time.Duration
+	// Concurrency 并发数
+	Concurrency int
+	// RootCAs 验证证书使用的根证书，为 nil 时使用系统根证书
+	RootCAs *x509.CertPool
+}
+
+// HTTPResult 单个 URL 的检查结果
+type HTTPResult struct {
+	URL        string
+	Host       string
+	StatusCode int
+	// CDN 根据响应头识别的 CDN
+	CDN string
+	// HTTPSRedirect HTTP 请求是否重定向到 HTTPS，未检查时为 nil
+	HTTPSRedirect *bool
+	// Headers 记录的响应头（小写名称）
+	Headers  map[string]string
+	Duration time.Duration
+	// Failures 未通过的检查项
+	Failures []string
+}
+
+// OK 检查是否全部通过
+func (r *HTTPResult) OK() bool {
+	return len(r.Failures) == 0
+}
+
+// CheckHTTP 并发检查多个 URL，结果顺序与输入一致
+// URL 未带协议时使用 https
+func CheckHTTP(ctx context.Context, urls []string, opts HTTPOptions) []HTTPResult {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultHTTPConcurrency
+	}
+
+	client := newHTTPClient(opts)
+	results := make([]HTTPResult, len(urls))
+
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, concurrency)
+
+	for i, rawURL := range urls {
+		wg.Add(1)
+		go func(i int, rawURL string) {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			results[i] = checkURL(ctx, client, rawURL, opts)
+		}(i, rawURL)
+	}
+
+	wg.Wait()
+	return results
+}
+
+// newHTTPClient 创建不跟随重定向的 HTTP 客户端
+func newHTTPClient(opts HTTPOptions) *http.Client {
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	dialer := &net.Dialer{Timeout: timeout}
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			host, port, err := net.SplitHostPort(addr)
+			if err != nil {
+				return nil, err
+			}
+			return dialer.DialContext(ctx, network, dialAddress(opts.Resolve, host, port))
+		},
+		TLSClientConfig:     &tls.Config{RootCAs: opts.RootCAs},
+		TLSHandshakeTimeout: timeout,
+		DisableKeepAlives:   true,
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   timeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// checkURL 检查单个 URL
+func checkURL(ctx context.Context, client *http.Client, rawURL string, opts HTTPOptions) HTTPResult {
+	result := HTTPResult{URL: rawURL}
+
+	target, err := normalizeURL(rawURL)
+	if err != nil {
+		result.Failures = append(result.Failures, err.Error())
+		return result
+	}
+	result.URL = target.String()
+	result.Host = target.Hostname()
+
+	start := time.Now()
+	resp, err := doRequest(ctx, client, target.String())
+	result.Duration = time.Since(start)
+	if err != nil {
+		result.Failures = append(result.Failures, fmt.Sprintf("请求失败: %v", err))
+		return result
+	}
+
+	result.StatusCode = resp.StatusCode
+	result.CDN = DetectCDN(resp.Header)
+	result.Headers = make(map[string]string)
+	for _, name := range reportedHeaders {
+		if v := resp.Header.Get(name); v != "" {
+			result.Headers[name] = v
+		}
+	}
+
+	expectStatus := opts.ExpectStatus
+	if len(expectStatus) == 0 {
+		expectStatus = []int{http.StatusOK}
+	}
+	if !slices.Contains(expectStatus, resp.StatusCode) {
+		result.Failures = append(result.Failures, fmt.Sprintf("状态码 %d 不在期望范围 %v 内", resp.StatusCode, expectStatus))
+	}
+
+	for _, expect := range opts.ExpectHeaders {
+		if err := checkHeader(resp.Header, expect); err != nil {
+			result.Failures = append(result.Failures, err.Error())
+		}
+	}
+
+	if opts.RequireHTTPS && target.Scheme == "https" {
+		redirect, err := checkHTTPSRedirect(ctx, client, target)
+		result.HTTPSRedirect = &redirect
+		if err != nil {
+			result.Failures = append(result.Failures, err.Error())
+		}
+	}
+
+	return result
+}
+
+// doRequest 发送 GET 请求并丢弃响应体
+func doRequest(ctx context.Context, client *http.Client, target string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "cloudctl-check")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<20))
+	resp.Body.Close()
+
+	return resp, nil
+}
+
+// checkHTTPSRedirect 检查 HTTP 请求是否重定向到同一主机的 HTTPS 地址
+func checkHTTPSRedirect(ctx context.Context, client *http.Client, target *url.URL) (bool, error) {
+	plain := *target
+	plain.Scheme = "http"
+	// 去掉 HTTPS 端口，使用 HTTP 默认端口
+	plain.Host = target.Hostname()
+	if strings.Contains(plain.Host, ":") {
+		plain.Host = "[" + plain.Host + "]"
+	}
+
+	resp, err := doRequest(ctx, client, plain.String())
+	if err != nil {
+		return false, fmt.Errorf("HTTP 请求失败: %v", err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusMovedPermanently, http.StatusFound, http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+	default:
+		return false, fmt.Errorf("HTTP 请求未重定向到 HTTPS (状态码 %d)", resp.StatusCode)
+	}
+
+	location, err := resp.Location()
+	if err != nil {
+		return false, fmt.Errorf("HTTP 重定向缺少 Location")
+	}
+	if location.Scheme != "https" || !strings.EqualFold(location.Hostname(), target.Hostname()) {
+		return false, fmt.Errorf("HTTP 重定向到 %s，不是同一主机的 HTTPS 地址", location)
+	}
+
+	return true, nil
+}
+
+// checkHeader 检查响应头是否符合期望
+func checkHeader(header http.Header, expect string) error {
+	name, want, hasValue := strings.Cut(expect, "=")
+	name = strings.TrimSpace(name)
+
+	values := header.Values(name)
+	if len(values) == 0 {
+		return fmt.Errorf("缺少响应头 %s", name)
+	}
+	if !hasValue {
+		return nil
+	}
+
+	want = strings.ToLower(strings.TrimSpace(want))
+	for _, v := range values {
+		if strings.Contains(strings.ToLower(v), want) {
+			return nil
+		}
+	}
+	return fmt.Errorf("响应头 %s 为 %q，不包含 %q", name, strings.Join(values, ", "), want)
+}
+
+// normalizeURL 解析 URL，未带协议时使用 https
+func normalizeURL(rawURL string) (*url.URL, error) {
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "https://" + rawURL
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("无效的 URL: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("不支持的协议: %s", u.Scheme)
+	}
+	if u.Hostname() == "" {
+		return nil, fmt.Errorf("URL 缺少主机名: %s", rawURL)
+	}
+	if u.Path == "" {
+		u.Path = "/"
+	}
+
+	return u, nil
+}
+
+// DetectCDN 根据响应头识别 CDN
+func DetectCDN(header http.Header) string {
+	if header.Get("cf-ray") != "" || strings.EqualFold(header.Get("server"), "cloudflare") {
+		return CDNCloudflare
+	}
+
+	if header.Get("x-amz-cf-id") != "" || header.Get("x-amz-cf-pop") != "" {
+		return CDNCloudFront
+	}
+	for _, name := range []string{"via", "x-cache"} {
+		if strings.Contains(strings.ToLower(header.Get(name)), "cloudfront") {
+			return CDNCloudFront
+		}
+	}
+
+	return CDNUnknown
+}
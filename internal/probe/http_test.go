@@ -0,0 +1,187 @@
+package probe
+
+import (
+	"context"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCheckHTTP(t *testing.T) {
+	secure := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Via", "1.1 abc.cloudfront.net (CloudFront)")
+		w.Header().Set("X-Cache", "Hit from cloudfront")
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer secure.Close()
+
+	plain := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Host == "noredirect.example.com" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		http.Redirect(w, r, "https://"+r.Host+r.URL.Path, http.StatusMovedPermanently)
+	}))
+	defer plain.Close()
+
+	pool := x509.NewCertPool()
+	pool.AddCert(secure.Certificate())
+
+	secureAddr := strings.TrimPrefix(secure.URL, "https://")
+	plainAddr := strings.TrimPrefix(plain.URL, "http://")
+	opts := HTTPOptions{
+		RequireHTTPS: true,
+		Resolve: map[string]string{
+			"example.com:443":            secureAddr,
+			"example.com:80":             plainAddr,
+			"noredirect.example.com:443": secureAddr,
+			"noredirect.example.com:80":  plainAddr,
+		},
+		Timeout: 5 * time.Second,
+		RootCAs: pool,
+	}
+
+	tests := []struct {
+		name         string
+		url          string
+		opts         func(HTTPOptions) HTTPOptions
+		wantOK       bool
+		wantStatus   int
+		wantRedirect bool
+	}{
+		{
+			name:         "全部通过",
+			url:          "example.com",
+			wantOK:       true,
+			wantStatus:   http.StatusOK,
+			wantRedirect: true,
+		},
+		{
+			name:         "状态码不符",
+			url:          "https://example.com/missing",
+			wantOK:       false,
+			wantStatus:   http.StatusNotFound,
+			wantRedirect: true,
+		},
+		{
+			name: "允许的状态码",
+			url:  "https://example.com/missing",
+			opts: func(o HTTPOptions) HTTPOptions {
+				o.ExpectStatus = []int{http.StatusOK, http.StatusNotFound}
+				return o
+			},
+			wantOK:       true,
+			wantStatus:   http.StatusNotFound,
+			wantRedirect: true,
+		},
+		{
+			name:         "未重定向到 HTTPS",
+			url:          "noredirect.example.com",
+			wantOK:       false,
+			wantStatus:   http.StatusOK,
+			wantRedirect: false,
+		},
+		{
+			name: "期望的响应头存在",
+			url:  "example.com",
+			opts: func(o HTTPOptions) HTTPOptions {
+				o.ExpectHeaders = []string{"via", "x-cache=hit"}
+				return o
+			},
+			wantOK:       true,
+			wantStatus:   http.StatusOK,
+			wantRedirect: true,
+		},
+		{
+			name: "缺少期望的响应头",
+			url:  "example.com",
+			opts: func(o HTTPOptions) HTTPOptions {
+				o.ExpectHeaders = []string{"cf-cache-status"}
+				return o
+			},
+			wantOK:       false,
+			wantStatus:   http.StatusOK,
+			wantRedirect: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := opts
+			if tt.opts != nil {
+				o = tt.opts(o)
+			}
+
+			results := CheckHTTP(context.Background(), []string{tt.url}, o)
+			if len(results) != 1 {
+				t.Fatalf("CheckHTTP() 返回 %d 个结果", len(results))
+			}
+			r := results[0]
+
+			if r.OK() != tt.wantOK {
+				t.Errorf("OK() = %v, want %v (%v)", r.OK(), tt.wantOK, r.Failures)
+			}
+			if r.StatusCode != tt.wantStatus {
+				t.Errorf("StatusCode = %d, want %d", r.StatusCode, tt.wantStatus)
+			}
+			if r.HTTPSRedirect == nil || *r.HTTPSRedirect != tt.wantRedirect {
+				t.Errorf("HTTPSRedirect = %v, want %v", r.HTTPSRedirect, tt.wantRedirect)
+			}
+			if r.CDN != CDNCloudFront {
+				t.Errorf("CDN = %q, want %q", r.CDN, CDNCloudFront)
+			}
+		})
+	}
+}
+
+func TestCheckHTTPOrder(t *testing.T) {
+	urls := []string{"ftp://example.com", "https://", "example.com"}
+	results := CheckHTTP(context.Background(), urls, HTTPOptions{
+		Resolve: map[string]string{"example.com": "127.0.0.1:1"},
+		Timeout: time.Second,
+	})
+
+	if len(results) != len(urls) {
+		t.Fatalf("CheckHTTP() 返回 %d 个结果, want %d", len(results), len(urls))
+	}
+	for i, r := range results {
+		if r.OK() {
+			t.Errorf("results[%d].OK() = true, want false", i)
+		}
+	}
+	if results[2].URL != "https://example.com/" {
+		t.Errorf("results[2].URL = %q, want %q", results[2].URL, "https://example.com/")
+	}
+}
+
+func TestDetectCDN(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    string
+	}{
+		{"Cloudflare cf-ray", map[string]string{"CF-RAY": "8a1b2c3d4e5f-SJC"}, CDNCloudflare},
+		{"Cloudflare server", map[string]string{"Server": "cloudflare"}, CDNCloudflare},
+		{"CloudFront x-amz-cf-id", map[string]string{"X-Amz-Cf-Id": "abc"}, CDNCloudFront},
+		{"CloudFront via", map[string]string{"Via": "1.1 abc.cloudfront.net (CloudFront)"}, CDNCloudFront},
+		{"CloudFront x-cache", map[string]string{"X-Cache": "Miss from cloudfront"}, CDNCloudFront},
+		{"未知", map[string]string{"Server": "nginx"}, CDNUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			for k, v := range tt.headers {
+				header.Set(k, v)
+			}
+			if got := DetectCDN(header); got != tt.want {
+				t.Errorf("DetectCDN() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package probe
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"math/big"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultTLSPort 默认 TLS 端口
+const DefaultTLSPort = 443
+
+// DefaultTimeout 默认连接超时时间
+const DefaultTimeout = 10 * time.Second
+
+// TLSOptions TLS 探测选项
+type TLSOptions struct {
+	// Port 未在主机名中指定端口时使用的端口，为 0 时使用 443
+	Port int
+	// Resolve 主机名到连接地址的映射，详见 dialAddress
+	Resolve map[string]string
+	// Timeout 连接和握手超时时间
+	Timeout time.Duration
+	// RootCAs 验证证书链使用的根证书，为 nil 时使用系统根证书
+	RootCAs *x509.CertPool
+}
+
+// CertificateInfo 服务端返回的证书信息
+type CertificateInfo struct {
+	Subject      string
+	Issuer       string
+	Serial       string
+	SANs         []string
+	KeyAlgorithm string
+	NotBefore    time.Time
+	NotAfter     time.Time
+}
+
+// TLSResult TLS 探测结果
+type TLSResult struct {
+	Host string
+	// Address 实际连接的地址
+	Address     string
+	Protocol    string
+	CipherSuite string
+	// Chain 服务端返回的证书链，第一个为叶子证书
+	Chain []CertificateInfo
+	// Verified 证书链和主机名是否验证通过
+	Verified    bool
+	VerifyError string
+}
+
+// Leaf 返回叶子证书，证书链为空时返回 nil
+func (r *TLSResult) Leaf() *CertificateInfo {
+	if len(r.Chain) == 0 {
+		return nil
+	}
+	return &r.Chain[0]
+}
+
+// ProbeTLS 使用 SNI 连接主机并获取服务端证书信息
+// host 可以带端口（如 example.com:8443）；证书验证失败不会返回错误，而是记录在结果中
+func ProbeTLS(ctx context.Context, host string, opts TLSOptions) (*TLSResult, error) {
+	serverName, address, err := resolveAddress(host, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	dialer := &tls.Dialer{
+		NetDialer: &net.Dialer{Timeout: timeout},
+		Config: &tls.Config{
+			ServerName: serverName,
+			// 证书在握手后单独验证，以便验证失败时仍能报告证书详情
+			InsecureSkipVerify: true,
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("连接 %s 失败: %w", address, err)
+	}
+	defer conn.Close()
+
+	state := conn.(*tls.Conn).ConnectionState()
+
+	result := &TLSResult{
+		Host:        serverName,
+		Address:     address,
+		Protocol:    tls.VersionName(state.Version),
+		CipherSuite: tls.CipherSuiteName(state.CipherSuite),
+	}
+	for _, cert := range state.PeerCertificates {
+		result.Chain = append(result.Chain, convertCertificate(cert))
+	}
+
+	if err := verifyChain(state.PeerCertificates, serverName, opts.RootCAs); err != nil {
+		result.VerifyError = err.Error()
+	} else {
+		result.Verified = true
+	}
+
+	return result, nil
+}
+
+// resolveAddress 解析 SNI 主机名和实际连接地址
+func resolveAddress(host string, opts TLSOptions) (string, string, error) {
+	serverName := host
+	port := opts.Port
+	if port == 0 {
+		port = DefaultTLSPort
+	}
+
+	if h, p, err := net.SplitHostPort(host); err == nil {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return "", "", fmt.Errorf("无效的端口: %s", p)
+		}
+		serverName = h
+		port = n
+	}
+
+	serverName = strings.TrimSuffix(strings.ToLower(serverName), ".")
+	if serverName == "" {
+		return "", "", fmt.Errorf("主机名不能为空")
+	}
+
+	return serverName, dialAddress(opts.Resolve, serverName, strconv.Itoa(port)), nil
+}
+
+// dialAddress 根据映射表返回实际连接地址
+// 映射的键可以是 host:port 或 host，前者优先；映射值未带端口时使用原端口
+func dialAddress(resolve map[string]string, host, port string) string {
+	target, ok := resolve[net.JoinHostPort(host, port)]
+	if !ok {
+		target, ok = resolve[host]
+	}
+	if !ok {
+		return net.JoinHostPort(host, port)
+	}
+
+	if _, _, err := net.SplitHostPort(target); err == nil {
+		return target
+	}
+	return net.JoinHostPort(target, port)
+}
+
+// verifyChain 验证证书链和主机名
+func verifyChain(certs []*x509.Certificate, serverName string, roots *x509.CertPool) error {
+	if len(certs) == 0 {
+		return fmt.Errorf("服务端未返回证书")
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	_, err := certs[0].Verify(x509.VerifyOptions{
+		DNSName:       serverName,
+		Roots:         roots,
+		Intermediates: intermediates,
+	})
+	return err
+}
+
+// convertCertificate 转换证书信息
+func convertCertificate(cert *x509.Certificate) CertificateInfo {
+	sans := append([]string{}, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+
+	return CertificateInfo{
+		Subject:      cert.Subject.String(),
+		Issuer:       cert.Issuer.String(),
+		Serial:       FormatSerial(cert.SerialNumber),
+		SANs:         sans,
+		KeyAlgorithm: keyAlgorithm(cert),
+		NotBefore:    cert.NotBefore,
+		NotAfter:     cert.NotAfter,
+	}
+}
+
+// acmCurveNames 椭圆曲线名称到 ACM 密钥算法名称的映射
+var acmCurveNames = map[string]string{
+	"P-256": "EC_prime256v1",
+	"P-384": "EC_secp384r1",
+	"P-521": "EC_secp521r1",
+}
+
+// keyAlgorithm 返回与 ACM 命名一致的密钥算法
+func keyAlgorithm(cert *x509.Certificate) string {
+	switch key := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		return fmt.Sprintf("RSA_%d", key.N.BitLen())
+	case *ecdsa.PublicKey:
+		if name, ok := acmCurveNames[key.Curve.Params().Name]; ok {
+			return name
+		}
+		return "EC_" + key.Curve.Params().Name
+	case ed25519.PublicKey:
+		return "ED25519"
+	default:
+		return cert.PublicKeyAlgorithm.String()
+	}
+}
+
+// FormatSerial 将证书序列号格式化为 ACM 使用的冒号分隔十六进制
+func FormatSerial(serial *big.Int) string {
+	if serial == nil {
+		return ""
+	}
+
+	b := serial.Bytes()
+	if len(b) == 0 {
+		b = []byte{0}
+	}
+
+	parts := make([]string, len(b))
+	for i, v := range b {
+		parts[i] = fmt.Sprintf("%02x", v)
+	}
+	return strings.Join(parts, ":")
+}
+
+// SameSerial 比较两个序列号是否相同，忽略大小写、分隔符和前导零
+func SameSerial(a, b string) bool {
+	return normalizeSerial(a) != "" && normalizeSerial(a) == normalizeSerial(b)
+}
+
+// normalizeSerial 规范化序列号
+func normalizeSerial(s string) string {
+	s = strings.ToLower(s)
+	s = strings.NewReplacer(":", "", "-", "", " ", "").Replace(s)
+	s = strings.TrimLeft(s, "0")
+	return s
+}
@@ -0,0 +1,222 @@
+package probe
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTLSServer(t *testing.T) (*httptest.Server, *x509.CertPool) {
+	t.Helper()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	t.Cleanup(server.Close)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+	return server, pool
+}
+
+func TestProbeTLS(t *testing.T) {
+	server, pool := newTLSServer(t)
+	address := strings.TrimPrefix(server.URL, "https://")
+
+	tests := []struct {
+		name         string
+		host         string
+		roots        *x509.CertPool
+		wantVerified bool
+	}{
+		{
+			name:         "证书覆盖主机名",
+			host:         "example.com",
+			roots:        pool,
+			wantVerified: true,
+		},
+		{
+			name:         "证书不覆盖主机名",
+			host:         "www.example.org",
+			roots:        pool,
+			wantVerified: false,
+		},
+		{
+			name:         "根证书不受信任",
+			host:         "example.com",
+			roots:        x509.NewCertPool(),
+			wantVerified: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ProbeTLS(context.Background(), tt.host, TLSOptions{
+				Resolve: map[string]string{tt.host: address},
+				Timeout: 5 * time.Second,
+				RootCAs: tt.roots,
+			})
+			if err != nil {
+				t.Fatalf("ProbeTLS() error = %v", err)
+			}
+
+			if result.Verified != tt.wantVerified {
+				t.Errorf("Verified = %v, want %v (%s)", result.Verified, tt.wantVerified, result.VerifyError)
+			}
+			if result.Address != address {
+				t.Errorf("Address = %q, want %q", result.Address, address)
+			}
+			if result.Protocol == "" || result.CipherSuite == "" {
+				t.Errorf("Protocol/CipherSuite 为空: %q %q", result.Protocol, result.CipherSuite)
+			}
+
+			leaf := result.Leaf()
+			if leaf == nil {
+				t.Fatal("Leaf() = nil")
+			}
+			if !SameSerial(leaf.Serial, FormatSerial(server.Certificate().SerialNumber)) {
+				t.Errorf("Serial = %q, want %q", leaf.Serial, FormatSerial(server.Certificate().SerialNumber))
+			}
+			if !leaf.NotAfter.Equal(server.Certificate().NotAfter) {
+				t.Errorf("NotAfter = %v, want %v", leaf.NotAfter, server.Certificate().NotAfter)
+			}
+		})
+	}
+}
+
+func TestProbeTLSConnectError(t *testing.T) {
+	server, _ := newTLSServer(t)
+	address := strings.TrimPrefix(server.URL, "https://")
+	server.Close()
+
+	_, err := ProbeTLS(context.Background(), "example.com", TLSOptions{
+		Resolve: map[string]string{"example.com": address},
+		Timeout: time.Second,
+	})
+	if err == nil {
+		t.Error("ProbeTLS() 期望返回错误")
+	}
+}
+
+func TestResolveAddress(t *testing.T) {
+	tests := []struct {
+		name        string
+		host        string
+		opts        TLSOptions
+		wantServer  string
+		wantAddress string
+		wantErr     bool
+	}{
+		{
+			name:        "默认端口",
+			host:        "Example.com.",
+			wantServer:  "example.com",
+			wantAddress: "example.com:443",
+		},
+		{
+			name:        "主机名带端口",
+			host:        "example.com:8443",
+			wantServer:  "example.com",
+			wantAddress: "example.com:8443",
+		},
+		{
+			name:        "指定默认端口",
+			host:        "example.com",
+			opts:        TLSOptions{Port: 8443},
+			wantServer:  "example.com",
+			wantAddress: "example.com:8443",
+		},
+		{
+			name:        "映射地址不带端口",
+			host:        "example.com",
+			opts:        TLSOptions{Resolve: map[string]string{"example.com": "127.0.0.1"}},
+			wantServer:  "example.com",
+			wantAddress: "127.0.0.1:443",
+		},
+		{
+			name:        "映射地址带端口",
+			host:        "example.com:443",
+			opts:        TLSOptions{Resolve: map[string]string{"example.com": "127.0.0.1:9443"}},
+			wantServer:  "example.com",
+			wantAddress: "127.0.0.1:9443",
+		},
+		{
+			name:    "无效端口",
+			host:    "example.com:abc",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, address, err := resolveAddress(tt.host, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolveAddress() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if server != tt.wantServer || address != tt.wantAddress {
+				t.Errorf("resolveAddress() = %q, %q, want %q, %q", server, address, tt.wantServer, tt.wantAddress)
+			}
+		})
+	}
+}
+
+func TestSerial(t *testing.T) {
+	tests := []struct {
+		name   string
+		serial *big.Int
+		want   string
+	}{
+		{"单字节", big.NewInt(10), "0a"},
+		{"多字节", big.NewInt(0x0102ff), "01:02:ff"},
+		{"零", big.NewInt(0), "00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatSerial(tt.serial); got != tt.want {
+				t.Errorf("FormatSerial() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if !SameSerial("0A:1B:2c", "a1b2c") {
+		t.Error("SameSerial() 应忽略大小写、分隔符和前导零")
+	}
+	if SameSerial("0a:1b", "0a:1c") {
+		t.Error("SameSerial() 不同序列号应返回 false")
+	}
+	if SameSerial("", "") {
+		t.Error("SameSerial() 空序列号应返回 false")
+	}
+}
+
+func TestKeyAlgorithm(t *testing.T) {
+	tests := []struct {
+		curve elliptic.Curve
+		want  string
+	}{
+		{elliptic.P256(), "EC_prime256v1"},
+		{elliptic.P384(), "EC_secp384r1"},
+		{elliptic.P521(), "EC_secp521r1"},
+	}
+
+	for _, tt := range tests {
+		key, err := ecdsa.GenerateKey(tt.curve, rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cert := &x509.Certificate{PublicKey: &key.PublicKey}
+		if got := keyAlgorithm(cert); got != tt.want {
+			t.Errorf("keyAlgorithm(%s) = %q, want %q", tt.curve.Params().Name, got, tt.want)
+		}
+	}
+}